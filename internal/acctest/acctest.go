@@ -0,0 +1,62 @@
+// Package acctest provides the shared acceptance-testing harness for this
+// provider. TestProviderFactories exercises the same SDKv2-upgraded +
+// framework mux that main.go serves in production, so acceptance tests
+// cover both provider implementations and the mux boundary between them.
+package acctest
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/auth0/terraform-provider-auth0/internal/provider"
+)
+
+var invalidTestNameChars = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// TestProviderFactories returns the ProtoV6ProviderFactories muxing the
+// SDKv2 provider (upgraded to protocol 6) and the framework provider, the
+// same way main.go assembles the real provider binary.
+func TestProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"auth0": func() (tfprotov6.ProviderServer, error) {
+			schema.DescriptionKind = schema.StringMarkdown
+
+			muxServer, err := tf6muxserver.NewMuxServer(
+				context.Background(),
+				func() tfprotov6.ProviderServer {
+					upgradedSdkProvider, err := tf5to6server.UpgradeServer(
+						context.Background(),
+						provider.New().GRPCProvider,
+					)
+					if err != nil {
+						log.Fatal(err)
+					}
+					return upgradedSdkProvider
+				},
+				providerserver.NewProtocol6(provider.NewAuth0Provider()),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return muxServer.ProviderServer(), nil
+		},
+	}
+}
+
+// ParseTestName replaces every occurrence of {{.testName}} in configuration
+// with a sanitized, lowercase version of testName, so resources created by
+// parallel test runs don't collide on name.
+func ParseTestName(configuration, testName string) string {
+	sanitized := strings.ToLower(invalidTestNameChars.ReplaceAllString(testName, "-"))
+
+	return strings.ReplaceAll(configuration, "{{.testName}}", sanitized)
+}
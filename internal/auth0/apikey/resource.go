@@ -0,0 +1,157 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+)
+
+// NewManagementAPIKeyResource will return a new auth0_management_api_key resource.
+func NewManagementAPIKeyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createManagementAPIKey,
+		UpdateContext: updateManagementAPIKey,
+		ReadContext:   readManagementAPIKey,
+		DeleteContext: deleteManagementAPIKey,
+		CustomizeDiff: customizeDiffManagementAPIKey,
+		Description: "Resource to issue short-lived Management API access tokens for a " +
+			"non-interactive (M2M) client, so downstream tooling can be given a " +
+			"least-privilege token instead of a long-lived `AUTH0_API_TOKEN`.",
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The client ID of the non-interactive (M2M) application to request tokens for.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The client secret of the non-interactive (M2M) application.",
+			},
+			"scopes": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Management API scopes to request, e.g. `read:users`, `update:users`.",
+			},
+			"ttl_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The maximum lifetime of the issued token, in seconds. The token is " +
+					"reissued on the next apply once this many seconds have elapsed since it was " +
+					"issued, even if it has not yet expired according to the client grant's own " +
+					"configured token lifetime.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The issued Management API access token.",
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The ISO 8601 formatted date the issued token expires at, or the " +
+					"`ttl_seconds` cutoff if that is earlier.",
+			},
+		},
+	}
+}
+
+func customizeDiffManagementAPIKey(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if diff.HasChange("client_secret") || diff.HasChange("scopes") || diff.HasChange("ttl_seconds") {
+		return nil
+	}
+
+	expiresAtRaw := diff.Get("expires_at").(string)
+	if expiresAtRaw == "" {
+		return nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, expiresAtRaw)
+	if err != nil {
+		return fmt.Errorf("invalid expires_at in state: %w", err)
+	}
+
+	if time.Now().After(expiresAt) {
+		if err := diff.SetNewComputed("token"); err != nil {
+			return err
+		}
+		if err := diff.SetNewComputed("expires_at"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createManagementAPIKey(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	issued, err := issueManagementAPIKey(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(issued.jti)
+
+	return readManagementAPIKey(ctx, data, meta)
+}
+
+func updateManagementAPIKey(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*config.Config).GetAPI()
+
+	oldJTI := data.Id()
+
+	issued, err := issueManagementAPIKey(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if oldJTI != "" && oldJTI != issued.jti {
+		if err := blacklistToken(ctx, api, oldJTI, issued.audience); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	data.SetId(issued.jti)
+
+	return readManagementAPIKey(ctx, data, meta)
+}
+
+// readManagementAPIKey is a no-op: an issued token has no corresponding
+// GET endpoint to refresh from, so state set by create/update is left as-is
+// and customizeDiffManagementAPIKey is relied on to detect when it's stale.
+func readManagementAPIKey(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func deleteManagementAPIKey(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*config.Config).GetAPI()
+
+	audience := managementAudience(meta.(*config.Config).GetDomain())
+
+	return diag.FromErr(blacklistToken(ctx, api, data.Id(), audience))
+}
+
+func blacklistToken(ctx context.Context, api *management.Management, jti, audience string) error {
+	return api.Blacklist.Create(ctx, &management.BlacklistedToken{
+		Aud: &audience,
+		JTI: &jti,
+	})
+}
+
+func managementAudience(domain string) string {
+	return fmt.Sprintf("https://%s/api/v2/", domain)
+}
@@ -0,0 +1,124 @@
+package apikey
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+)
+
+// issuedToken describes a Management API access token obtained on behalf of
+// the configured non-interactive client.
+type issuedToken struct {
+	token     string
+	jti       string
+	expiresAt time.Time
+	audience  string
+}
+
+// issueManagementAPIKey requests a new client_credentials token scoped to
+// the configured scopes, capping its recorded lifetime at ttl_seconds when
+// set.
+func issueManagementAPIKey(ctx context.Context, data *schema.ResourceData, meta interface{}) (*issuedToken, error) {
+	domain := meta.(*config.Config).GetDomain()
+	audience := managementAudience(domain)
+
+	clientID := data.Get("client_id").(string)
+	clientSecret := data.Get("client_secret").(string)
+	scopes := expandScopes(data.Get("scopes").([]interface{}))
+
+	token, err := requestClientCredentialsToken(ctx, domain, clientID, clientSecret, audience, scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return nil, fmt.Errorf("failed to parse issued token: %w", err)
+	}
+
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return nil, fmt.Errorf("issued token is missing a jti claim")
+	}
+
+	expiresAt := time.Now().UTC()
+	if exp, ok := claims["exp"].(float64); ok {
+		expiresAt = time.Unix(int64(exp), 0).UTC()
+	}
+
+	if ttlSeconds := data.Get("ttl_seconds").(int); ttlSeconds > 0 {
+		if capped := time.Now().UTC().Add(time.Duration(ttlSeconds) * time.Second); capped.Before(expiresAt) {
+			expiresAt = capped
+		}
+	}
+
+	if err := data.Set("token", token); err != nil {
+		return nil, err
+	}
+	if err := data.Set("expires_at", expiresAt.Format(time.RFC3339)); err != nil {
+		return nil, err
+	}
+
+	return &issuedToken{token: token, jti: jti, expiresAt: expiresAt, audience: audience}, nil
+}
+
+func expandScopes(raw []interface{}) []string {
+	scopes := make([]string, 0, len(raw))
+	for _, s := range raw {
+		scopes = append(scopes, s.(string))
+	}
+	return scopes
+}
+
+// requestClientCredentialsToken exchanges the client's own credentials for a
+// Management API access token via grant_type=client_credentials, the same
+// flow a human would run by hand against /oauth/token.
+func requestClientCredentialsToken(ctx context.Context, domain, clientID, clientSecret, audience string, scopes []string) (string, error) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+		"audience":      {audience},
+	}
+	if len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, fmt.Sprintf("https://%s/oauth/token", domain), strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request management api key: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var payload struct {
+		AccessToken      string `json:"access_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to issue management api key: %s: %s", payload.Error, payload.ErrorDescription)
+	}
+
+	return payload.AccessToken, nil
+}
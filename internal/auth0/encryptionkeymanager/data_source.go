@@ -0,0 +1,87 @@
+package encryptionkeymanager
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+)
+
+// NewEncryptionKeysDataSource will return a new auth0_encryption_keys data source.
+func NewEncryptionKeysDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readEncryptionKeysDataSource,
+		Description: "Data source to retrieve all encryption keys and their current states.",
+		Schema: map[string]*schema.Schema{
+			"encryption_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All encryption keys.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The key ID of the encryption key.",
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: "The type of the encryption key. One of " +
+								"`customer-provided-root-key`, `environment-root-key`, " +
+								"or `tenant-master-key`.",
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: "The state of the encryption key. One of " +
+								"`pre-activation`, `active`, `deactivated`, or `destroyed`.",
+						},
+						"parent_key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The key ID of the parent wrapping key.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ISO 8601 formatted date the encryption key was created.",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ISO 8601 formatted date the encryption key was updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readEncryptionKeysDataSource(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := meta.(*config.Config).GetAPI()
+
+	encryptionKeys := make([]*management.EncryptionKey, 0)
+	page := 0
+	for {
+		encryptionKeyList, err := api.EncryptionKey.List(ctx, management.Page(page), management.PerPage(5))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		encryptionKeys = append(encryptionKeys, encryptionKeyList.Keys...)
+		if !encryptionKeyList.HasNext() {
+			break
+		}
+		page++
+	}
+
+	data.SetId(id.UniqueId())
+
+	return diag.FromErr(data.Set("encryption_keys", flattenEncryptionKeys(encryptionKeys)))
+}
@@ -0,0 +1,310 @@
+package encryptionkeymanager
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/auth0/go-auth0"
+
+	"github.com/auth0/terraform-provider-auth0/internal/framework/base"
+	"github.com/auth0/terraform-provider-auth0/internal/framework/schemaconv"
+)
+
+// frameworkEncryptionKeyManagerResource is the plugin-framework port of
+// NewEncryptionKeyManagerResource. tf6muxserver.NewMuxServer routes by
+// resource type name from a static table built before either provider is
+// configured, so this can't share auth0_encryption_key_manager's type name
+// with the SDKv2 version while both are registered: it's registered under
+// its own type name for this release, so it can be tried ahead of the
+// SDKv2 version's removal, at which point it takes over the original name.
+//
+// Feature parity with the SDKv2 version is intentionally partial for this
+// first port: wrapping_source, rotation_schedule, and the data source stay
+// SDKv2-only until the reflection-based schema skeleton in
+// internal/framework/schemaconv has been extended to cover nested blocks.
+// MoveState (below) already lets existing auth0_encryption_key_manager
+// instances migrate onto this resource for the fields it does support, so
+// the missing parity is the only thing left blocking retiring the SDKv2
+// version outright.
+type frameworkEncryptionKeyManagerResource struct {
+	base.BaseResource
+}
+
+// NewEncryptionKeyManagerFrameworkResource returns the framework-native
+// preview of the auth0_encryption_key_manager resource, registered as
+// auth0_encryption_key_manager_framework until it replaces the SDKv2
+// version outright.
+func NewEncryptionKeyManagerFrameworkResource() fwresource.Resource {
+	return &frameworkEncryptionKeyManagerResource{
+		BaseResource: base.NewBaseResource("encryption_key_manager_framework"),
+	}
+}
+
+type encryptionKeyManagerModel struct {
+	ID               types.String `tfsdk:"id"`
+	KeyRotationID    types.String `tfsdk:"key_rotation_id"`
+	OperationTimeout types.String `tfsdk:"operation_timeout"`
+	RootKeyID        types.String `tfsdk:"root_key_id"`
+	WrappedKey       types.String `tfsdk:"wrapped_key"`
+}
+
+func (r *frameworkEncryptionKeyManagerResource) Schema(_ context.Context, _ fwresource.SchemaRequest, response *fwresource.SchemaResponse) {
+	response.Schema = fwschema.Schema{
+		Description: "Resource to allow the rekeying of your tenant master key. " +
+			"This is a preview of the plugin-framework port of the resource, " +
+			"registered as auth0_encryption_key_manager_framework ahead of the " +
+			"SDKv2 version's removal.",
+		Attributes: map[string]fwschema.Attribute{
+			"id": fwschema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_rotation_id": fwschema.StringAttribute{
+				Optional: true,
+				Description: "If this value is changed, the encryption keys will be rotated. " +
+					"A UUID is recommended for the key_rotation_id.",
+			},
+			"operation_timeout": fwschema.StringAttribute{
+				Optional: true,
+				Description: "The maximum time to wait for asynchronous key operations to " +
+					"complete, as a Go duration string (e.g. \"45m\").",
+			},
+			"root_key_id": fwschema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The key ID of the customer provided root key being provisioned. " +
+					"Leave unset to let the provider create one.",
+			},
+			"wrapped_key": fwschema.StringAttribute{
+				Optional: true,
+				Description: "The base64-encoded customer provided root key, wrapped using the " +
+					"public wrapping key returned for root_key_id.",
+			},
+		},
+	}
+}
+
+func (r *frameworkEncryptionKeyManagerResource) Create(ctx context.Context, request fwresource.CreateRequest, response *fwresource.CreateResponse) {
+	var data encryptionKeyManagerModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(r.typeName() + "-singleton")
+
+	// Unlike Update, Create never calls Rekey: a newly created resource has
+	// no prior key_rotation_id to diff against, matching the SDKv2 version's
+	// !data.IsNewResource() guard.
+	response.Diagnostics.Append(r.provisionRootKey(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *frameworkEncryptionKeyManagerResource) Read(ctx context.Context, request fwresource.ReadRequest, response *fwresource.ReadResponse) {
+	var data encryptionKeyManagerModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rootKeyID := data.RootKeyID.ValueString()
+	if rootKeyID != "" {
+		key, err := r.API().EncryptionKey.Read(ctx, rootKeyID)
+		if err != nil {
+			response.Diagnostics.Append(base.DiagnosticsFromError(err)...)
+			return
+		}
+		data.RootKeyID = types.StringValue(key.GetKID())
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *frameworkEncryptionKeyManagerResource) Update(ctx context.Context, request fwresource.UpdateRequest, response *fwresource.UpdateResponse) {
+	var state encryptionKeyManagerModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var data encryptionKeyManagerModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only rekey when key_rotation_id actually changed, the same guard the
+	// SDKv2 version applies with data.HasChange("key_rotation_id"); otherwise
+	// any unrelated attribute change (e.g. operation_timeout) would trigger a
+	// full tenant master-key rotation on every apply.
+	keyRotationID := data.KeyRotationID.ValueString()
+	if keyRotationID != "" && keyRotationID != state.KeyRotationID.ValueString() {
+		if err := r.API().EncryptionKey.Rekey(ctx); err != nil {
+			response.Diagnostics.Append(base.DiagnosticsFromError(err)...)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(r.provisionRootKey(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *frameworkEncryptionKeyManagerResource) Delete(ctx context.Context, request fwresource.DeleteRequest, response *fwresource.DeleteResponse) {
+	var data encryptionKeyManagerModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rootKeyID := data.RootKeyID.ValueString()
+	if rootKeyID == "" {
+		return
+	}
+
+	attempts, err := waitAttempts(data.OperationTimeout.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(base.DiagnosticsFromError(err)...)
+		return
+	}
+
+	if err := removeKey(ctx, r.API(), rootKeyID, attempts); err != nil {
+		response.Diagnostics.Append(base.DiagnosticsFromError(err)...)
+	}
+}
+
+// provisionRootKey creates the customer provided root key if one doesn't
+// exist yet and imports a wrapped key if one was supplied, reusing
+// createRootKey/importWrappedKey from resource.go and polling them with
+// internal/wait.Until exactly as the SDKv2 version does, so the two
+// implementations share operational behavior even though their schemas
+// have diverged. Rekeying is handled by the caller, since it must be
+// gated on an actual key_rotation_id change rather than on presence alone.
+func (r *frameworkEncryptionKeyManagerResource) provisionRootKey(ctx context.Context, data *encryptionKeyManagerModel) diag.Diagnostics {
+	api := r.API()
+
+	attempts, err := waitAttempts(data.OperationTimeout.ValueString())
+	if err != nil {
+		return base.DiagnosticsFromError(err)
+	}
+
+	rootKeyID := data.RootKeyID.ValueString()
+	wrappedKey := data.WrappedKey.ValueString()
+
+	if rootKeyID == "" {
+		rootKey, _, err := createRootKey(ctx, api, attempts)
+		if err != nil {
+			return base.DiagnosticsFromError(err)
+		}
+		data.RootKeyID = types.StringValue(rootKey.GetKID())
+		rootKeyID = rootKey.GetKID()
+	}
+
+	if wrappedKey != "" {
+		if err := importWrappedKey(ctx, api, auth0.String(rootKeyID), auth0.String(wrappedKey), attempts); err != nil {
+			return base.DiagnosticsFromError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *frameworkEncryptionKeyManagerResource) typeName() string {
+	return "auth0_encryption_key_manager_framework"
+}
+
+// MoveState lets `terraform state mv`/a `moved` block migrate an
+// auth0_encryption_key_manager instance onto this resource. Only the fields
+// this resource's schema actually has are read back out of the SDKv2 source
+// state (key_rotation_id, operation_timeout, and the nested
+// customer_provided_root_key's key_id/wrapped_key); wrapping_source and
+// rotation_schedule, which this preview doesn't yet model, are dropped.
+func (r *frameworkEncryptionKeyManagerResource) MoveState(_ context.Context) []fwresource.StateMover {
+	sourceSchema := encryptionKeyManagerMoveStateSourceSchema()
+
+	return []fwresource.StateMover{
+		{
+			SourceSchema: &sourceSchema,
+			StateMover: func(ctx context.Context, request fwresource.MoveStateRequest, response *fwresource.MoveStateResponse) {
+				if request.SourceTypeName != "auth0_encryption_key_manager" {
+					return
+				}
+
+				var data encryptionKeyManagerModel
+				data.ID = types.StringValue(r.typeName() + "-singleton")
+
+				response.Diagnostics.Append(request.SourceState.GetAttribute(ctx, path.Root("key_rotation_id"), &data.KeyRotationID)...)
+				response.Diagnostics.Append(request.SourceState.GetAttribute(ctx, path.Root("operation_timeout"), &data.OperationTimeout)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				// customer_provided_root_key is itself optional, so only read
+				// its nested fields once it's confirmed to be present.
+				var rootKeys types.List
+				response.Diagnostics.Append(request.SourceState.GetAttribute(ctx, path.Root("customer_provided_root_key"), &rootKeys)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				if !rootKeys.IsNull() && len(rootKeys.Elements()) > 0 {
+					rootKeyPath := path.Root("customer_provided_root_key").AtListIndex(0)
+					response.Diagnostics.Append(request.SourceState.GetAttribute(ctx, rootKeyPath.AtName("key_id"), &data.RootKeyID)...)
+					response.Diagnostics.Append(request.SourceState.GetAttribute(ctx, rootKeyPath.AtName("wrapped_key"), &data.WrappedKey)...)
+					if response.Diagnostics.HasError() {
+						return
+					}
+				}
+
+				response.Diagnostics.Append(response.TargetState.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// encryptionKeyManagerMoveStateSourceSchema declares just enough of
+// auth0_encryption_key_manager's schema for MoveState to unmarshal its
+// source state: key_rotation_id, operation_timeout, and the nested
+// customer_provided_root_key block, converted from the SDKv2 schema via
+// schemaconv so the two stay in sync. The framework only uses this to
+// populate MoveStateRequest.SourceState; fields it omits (wrapping_source,
+// rotation_schedule) are simply ignored in the source JSON, per
+// tftypes.ValueFromJSONOpts.IgnoreUndefinedAttributes.
+func encryptionKeyManagerMoveStateSourceSchema() fwschema.Schema {
+	return fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"key_rotation_id":   fwschema.StringAttribute{Optional: true},
+			"operation_timeout": fwschema.StringAttribute{Optional: true},
+			"customer_provided_root_key": fwschema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: fwschema.NestedAttributeObject{
+					// MoveState only reads key_id and wrapped_key back out of
+					// this block, but declaring the full block (the same one
+					// resource.go's Elem uses) keeps it in step with
+					// resource.go instead of keeping a second, hand-trimmed
+					// copy of it in sync by hand.
+					Attributes: schemaconv.ConvertAttributes(customerProvidedRootKeySchema()),
+				},
+			},
+		},
+	}
+}
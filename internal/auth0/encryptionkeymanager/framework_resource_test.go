@@ -0,0 +1,83 @@
+package encryptionkeymanager_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/auth0/terraform-provider-auth0/internal/acctest"
+)
+
+// TestAccFrameworkEncryptionKeyManagerOptIn exercises
+// auth0_encryption_key_manager_framework through the mux, so the framework
+// port is reachable at all before it takes over the
+// auth0_encryption_key_manager name from the SDKv2 version.
+const testAccFrameworkEncryptionKeyManagerOptIn = `
+resource "auth0_encryption_key_manager_framework" "my_keys" {
+}
+`
+
+func TestAccFrameworkEncryptionKeyManagerOptIn(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ParseTestName(testAccFrameworkEncryptionKeyManagerOptIn, t.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("auth0_encryption_key_manager_framework.my_keys", "id"),
+				),
+			},
+		},
+	})
+}
+
+// testAccFrameworkEncryptionKeyManagerMoveStateBefore provisions the
+// SDKv2 auth0_encryption_key_manager resource whose state
+// TestAccFrameworkEncryptionKeyManagerMoveState then moves onto the
+// framework port.
+const testAccFrameworkEncryptionKeyManagerMoveStateBefore = `
+resource "auth0_encryption_key_manager" "my_keys" {
+	key_rotation_id = "move-state-test"
+}
+`
+
+// testAccFrameworkEncryptionKeyManagerMoveStateAfter replaces the SDKv2
+// resource with the framework one at the same config address, using a moved
+// block so Terraform calls MoveResourceState instead of destroying and
+// recreating it.
+const testAccFrameworkEncryptionKeyManagerMoveStateAfter = `
+moved {
+	from = auth0_encryption_key_manager.my_keys
+	to   = auth0_encryption_key_manager_framework.my_keys
+}
+
+resource "auth0_encryption_key_manager_framework" "my_keys" {
+	key_rotation_id = "move-state-test"
+}
+`
+
+// TestAccFrameworkEncryptionKeyManagerMoveState verifies
+// frameworkEncryptionKeyManagerResource.MoveState: key_rotation_id written
+// by the SDKv2 resource must read back unchanged through the framework
+// resource after the moved block is applied, with no destroy/recreate.
+func TestAccFrameworkEncryptionKeyManagerMoveState(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFrameworkEncryptionKeyManagerMoveStateBefore,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("auth0_encryption_key_manager.my_keys", "id"),
+					resource.TestCheckResourceAttr("auth0_encryption_key_manager.my_keys", "key_rotation_id", "move-state-test"),
+				),
+			},
+			{
+				Config: testAccFrameworkEncryptionKeyManagerMoveStateAfter,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("auth0_encryption_key_manager_framework.my_keys", "id"),
+					resource.TestCheckResourceAttr("auth0_encryption_key_manager_framework.my_keys", "key_rotation_id", "move-state-test"),
+				),
+			},
+		},
+	})
+}
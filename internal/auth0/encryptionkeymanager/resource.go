@@ -25,6 +25,7 @@ func NewEncryptionKeyManagerResource() *schema.Resource {
 		UpdateContext: updateEncryptionKeyManager,
 		ReadContext:   readEncryptionKeyManager,
 		DeleteContext: deleteEncryptionKeyManager,
+		CustomizeDiff: customizeDiffRotationSchedule,
 		Description:   "Resource to allow the rekeying of your tenant master key.",
 		Schema: map[string]*schema.Schema{
 			"key_rotation_id": {
@@ -42,63 +43,255 @@ func NewEncryptionKeyManagerResource() *schema.Resource {
 					"`public_wrapping_key` can be retreived from the resource, and the new root " +
 					"key should be generated by the customer and wrapped with the wrapping key, " +
 					"then base64-encoded and added as the `wrapped_key` attribute.",
+				Elem: &schema.Resource{
+					Schema: customerProvidedRootKeySchema(),
+				},
+			},
+			"operation_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The maximum time to wait for asynchronous key operations (root key creation, import, " +
+					"delete) to complete, as a Go duration string (e.g. `\"45m\"`). HSM-backed wrapping " +
+					"sources can exceed the provider's default budget of `33m20s`.",
+			},
+			"rotation_schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: "Configures time- and event-driven auto-rotation of the tenant master key, " +
+					"as an alternative to manually changing `key_rotation_id`.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"wrapped_key": {
+						"interval": {
 							Type:     schema.TypeString,
 							Optional: true,
-							Description: "The base64-encoded customer provided root key, " +
-								"wrapped using the `public_wrapping_key`. This can be removed " +
-								"after the wrapped key has been applied.",
-						},
-						"public_wrapping_key": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "The public wrapping key in PEM format.",
+							Description: "How often to rotate, as a Go duration string (e.g. `\"720h\"`). " +
+								"The key is rotated whenever `floor(now / interval)` changes between applies.",
 						},
-						"wrapping_algorithm": {
+						"not_before": {
 							Type:     schema.TypeString,
-							Computed: true,
-							Description: "The algorithm that should be used to wrap the " +
-								"customer provided root key. Should be `CKM_RSA_AES_KEY_WRAP`.",
+							Optional: true,
+							Description: "An RFC 3339 timestamp before which rotation is never triggered, " +
+								"even if otherwise due.",
 						},
-						"key_id": {
+						"not_after": {
 							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "The key ID of the customer provided root key.",
+							Optional:    true,
+							Description: "An RFC 3339 timestamp after which rotation is no longer triggered automatically.",
 						},
-						"type": {
+						"rotate_on_apply_after": {
 							Type:     schema.TypeString,
-							Computed: true,
-							Description: "The type of the customer provided root key. " +
-								"Should be `customer-provided-root-key`.",
+							Optional: true,
+							Description: "An RFC 3339 timestamp. The key is rotated once on the first apply " +
+								"at or after this time.",
 						},
-						"state": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Description: "The state of the encryption key. One of " +
-								"`pre-activation`, `active`, `deactivated`, or `destroyed`.",
+						"rotate_on_provider_upgrade": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Description: "When `true`, rotates the key whenever the provider version recorded " +
+								"in state differs from the version currently running.",
 						},
-						"parent_key_id": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "The key ID of the parent wrapping key.",
+					},
+				},
+			},
+			"last_rotated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The ISO 8601 formatted date the key was last rotated through a change of " +
+					"`key_rotation_id`, or the date the resource was created if it has not been rotated since.",
+			},
+			"next_rotation_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The ISO 8601 formatted date the key is next due to be rotated by " +
+					"`rotation_schedule`, if an `interval` is configured.",
+			},
+			"provider_version_at_last_rotation": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The provider version that last rotated the key, or created the resource, " +
+					"while `rotate_on_provider_upgrade` was enabled.",
+			},
+			"wrapping_source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: "Configures a KMS or HSM backend that automatically generates and wraps " +
+					"the customer provided root key, so `wrapped_key` no longer needs to be computed " +
+					"and pasted in by hand. Exactly one of the nested backend blocks must be set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_kms": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Generates the root key using AWS KMS.",
+							ExactlyOneOf: []string{
+								"wrapping_source.0.aws_kms",
+								"wrapping_source.0.gcp_kms",
+								"wrapping_source.0.azure_key_vault",
+								"wrapping_source.0.pkcs11",
+								"wrapping_source.0.local_file",
+							},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_arn": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ARN of the AWS KMS key used to generate the root key.",
+									},
+									"profile": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The AWS shared config profile to use. Defaults to the standard AWS credential chain.",
+									},
+									"role": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "An IAM role ARN to assume before calling AWS KMS.",
+									},
+								},
+							},
 						},
-						"created_at": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Description: "The ISO 8601 formatted date the customer provided " +
-								"root key was created.",
+						"gcp_kms": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Generates the root key using Google Cloud KMS.",
+							ExactlyOneOf: []string{
+								"wrapping_source.0.aws_kms",
+								"wrapping_source.0.gcp_kms",
+								"wrapping_source.0.azure_key_vault",
+								"wrapping_source.0.pkcs11",
+								"wrapping_source.0.local_file",
+							},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"project": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The GCP project that owns the Cloud KMS key ring.",
+									},
+									"location": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The location of the Cloud KMS key ring.",
+									},
+									"keyring": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The Cloud KMS key ring name.",
+									},
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The Cloud KMS crypto key name.",
+									},
+								},
+							},
 						},
-						"updated_at": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Description: "The ISO 8601 formatted date the customer provided " +
-								"root key was updated.",
+						"azure_key_vault": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Description: "Generates the root key with the vault's `GetRandomBytes` operation, which " +
+								"only Azure Managed HSM supports. `key_name` and `key_version` are accepted but not used.",
+							ExactlyOneOf: []string{
+								"wrapping_source.0.aws_kms",
+								"wrapping_source.0.gcp_kms",
+								"wrapping_source.0.azure_key_vault",
+								"wrapping_source.0.pkcs11",
+								"wrapping_source.0.local_file",
+							},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"vault_url": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The URL of the Azure Managed HSM, e.g. `https://my-hsm.managedhsm.azure.net`.",
+									},
+									"key_name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the Key Vault key.",
+									},
+									"key_version": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The version of the Key Vault key. Defaults to the latest version.",
+									},
+								},
+							},
+						},
+						"pkcs11": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Generates the root key on an HSM reachable through a PKCS#11 module.",
+							ExactlyOneOf: []string{
+								"wrapping_source.0.aws_kms",
+								"wrapping_source.0.gcp_kms",
+								"wrapping_source.0.azure_key_vault",
+								"wrapping_source.0.pkcs11",
+								"wrapping_source.0.local_file",
+							},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"module_path": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The path to the PKCS#11 module (`.so`/`.dll`) provided by the HSM vendor.",
+									},
+									"slot_label": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The label of the PKCS#11 token/slot to use.",
+									},
+									"key_label": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The label under which the root key is generated on the HSM.",
+									},
+									"pin_env": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: "The name of the environment variable holding the token PIN. " +
+											"The PIN itself is never read from configuration.",
+									},
+								},
+							},
+						},
+						"local_file": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Reads a raw 256-bit AES root key from a local file. Intended for testing only.",
+							ExactlyOneOf: []string{
+								"wrapping_source.0.aws_kms",
+								"wrapping_source.0.gcp_kms",
+								"wrapping_source.0.azure_key_vault",
+								"wrapping_source.0.pkcs11",
+								"wrapping_source.0.local_file",
+							},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The path to the raw 256-bit AES root key.",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
+			"wrapped_key_source": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Indicates how `wrapped_key` was populated. Either `manual`, when the user " +
+					"supplied it directly, or `computed`, when it was generated by the configured `wrapping_source`.",
+			},
 			"encryption_keys": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -145,6 +338,68 @@ func NewEncryptionKeyManagerResource() *schema.Resource {
 	}
 }
 
+// customerProvidedRootKeySchema returns the schema for the nested
+// customer_provided_root_key block, factored out so it can also be used to
+// build the equivalent framework attribute for
+// frameworkEncryptionKeyManagerResource's MoveState support, via
+// schemaconv.ConvertAttributes.
+func customerProvidedRootKeySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"wrapped_key": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "The base64-encoded customer provided root key, " +
+				"wrapped using the `public_wrapping_key`. This can be removed " +
+				"after the wrapped key has been applied.",
+		},
+		"public_wrapping_key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The public wrapping key in PEM format.",
+		},
+		"wrapping_algorithm": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "The algorithm that should be used to wrap the " +
+				"customer provided root key. Should be `CKM_RSA_AES_KEY_WRAP`.",
+		},
+		"key_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The key ID of the customer provided root key.",
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "The type of the customer provided root key. " +
+				"Should be `customer-provided-root-key`.",
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "The state of the encryption key. One of " +
+				"`pre-activation`, `active`, `deactivated`, or `destroyed`.",
+		},
+		"parent_key_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The key ID of the parent wrapping key.",
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "The ISO 8601 formatted date the customer provided " +
+				"root key was created.",
+		},
+		"updated_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "The ISO 8601 formatted date the customer provided " +
+				"root key was updated.",
+		},
+	}
+}
+
 func createEncryptionKeyManager(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	data.SetId(id.UniqueId())
 
@@ -155,12 +410,33 @@ func updateEncryptionKeyManager(ctx context.Context, data *schema.ResourceData,
 	api := meta.(*config.Config).GetAPI()
 	config := data.GetRawConfig()
 
-	if !data.IsNewResource() && data.HasChange("key_rotation_id") {
+	attempts, err := waitAttempts(data.Get("operation_timeout").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if data.IsNewResource() {
+		// Create never calls Rekey (there's no prior key_rotation_id to diff
+		// against), but last_rotated_at/provider_version_at_last_rotation still
+		// need a baseline as of creation: otherwise a fresh resource with
+		// rotate_on_provider_upgrade leaves provider_version_at_last_rotation
+		// empty, so the very next plan sees it differ from the running
+		// provider version and schedules an unwanted rotation; and a
+		// rotate_on_apply_after timestamp already in the past gets baked into
+		// the initial key_rotation_id during this same create plan without
+		// ever being recorded as handled.
+		if err := recordRotation(data); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if data.HasChange("key_rotation_id") {
 		keyRotationID := data.Get("key_rotation_id").(string)
 		if len(keyRotationID) > 0 {
 			if err := api.EncryptionKey.Rekey(ctx); err != nil {
 				return diag.FromErr(err)
 			}
+			if err := recordRotation(data); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
@@ -174,7 +450,7 @@ func updateEncryptionKeyManager(ctx context.Context, data *schema.ResourceData,
 		if rootKeyAttrib.IsNull() || rootKeyAttrib.LengthInt() == 0 {
 			// The customer_provided_root_key block is not present, check if there was a key.
 			if len(rootKeyID) > 0 {
-				if err := removeKey(ctx, api, rootKeyID); err != nil {
+				if err := removeKey(ctx, api, rootKeyID, attempts); err != nil {
 					return diag.FromErr(err)
 				}
 			}
@@ -185,25 +461,49 @@ func updateEncryptionKeyManager(ctx context.Context, data *schema.ResourceData,
 				wrappedKey = value.String(cfg.GetAttr("wrapped_key"))
 				return stop
 			})
+
+			// If we don't have a root key in progress yet, or this block is newly
+			// created, create a new one before attempting to import a wrapped key,
+			// so a wrapping_source can compute and import against it in this same
+			// apply instead of requiring a second one.
+			if len(rootKeyID) == 0 || (oldCountValue.(int) == 0 && newCountValue.(int) == 1) {
+				rootKey, wrappingKey, err := createRootKey(ctx, api, attempts)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				if err := data.Set("customer_provided_root_key", flattenCustomerProvidedRootKey(data, rootKey, wrappingKey)); err != nil {
+					return diag.FromErr(err)
+				}
+				rootKeyID = rootKey.GetKID()
+				rootKeyState = rootKey.GetState()
+				publicWrappingKey = wrappingKey.GetPublicKey()
+			}
+
 			if wrappedKey != nil {
 				if len(rootKeyID) > 0 && rootKeyState == "pre-activation" && len(publicWrappingKey) > 0 {
-					if err := importWrappedKey(ctx, api, auth0.String(rootKeyID), wrappedKey); err != nil {
+					if err := importWrappedKey(ctx, api, auth0.String(rootKeyID), wrappedKey, attempts); err != nil {
+						return diag.FromErr(err)
+					}
+					if err := data.Set("wrapped_key_source", "manual"); err != nil {
 						return diag.FromErr(err)
 					}
 				} else if len(rootKeyID) == 0 || len(publicWrappingKey) == 0 {
 					return diag.FromErr(fmt.Errorf("The wrapped_key attribute should not be specified in the " +
 						"customer_provided_root_key block until after the public_wrapping_key has been generated"))
 				}
-			}
-
-			// If we don't have a root key in progress yet, or this block is newly created
-			// create a new one.
-			if len(rootKeyID) == 0 || (oldCountValue.(int) == 0 && newCountValue.(int) == 1) {
-				if rootKey, wrappingKey, err := createRootKey(ctx, api); err != nil {
-					return diag.FromErr(err)
-				} else if err := data.Set("customer_provided_root_key", flattenCustomerProvidedRootKey(data, rootKey, wrappingKey)); err != nil {
+			} else if len(rootKeyID) > 0 && rootKeyState == "pre-activation" && len(publicWrappingKey) > 0 {
+				computedWrappedKey, err := computeWrappedKey(ctx, data, publicWrappingKey)
+				if err != nil {
 					return diag.FromErr(err)
 				}
+				if computedWrappedKey != nil {
+					if err := importWrappedKey(ctx, api, auth0.String(rootKeyID), computedWrappedKey, attempts); err != nil {
+						return diag.FromErr(err)
+					}
+					if err := data.Set("wrapped_key_source", "computed"); err != nil {
+						return diag.FromErr(err)
+					}
+				}
 			}
 		}
 	}
@@ -244,27 +544,41 @@ func readEncryptionKeyManager(ctx context.Context, data *schema.ResourceData, me
 		}
 	}
 
-	return diag.FromErr(data.Set("encryption_keys", flattenEncryptionKeys(encryptionKeys)))
+	if err := data.Set("encryption_keys", flattenEncryptionKeys(encryptionKeys)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	next, err := computeNextRotationAt(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(data.Set("next_rotation_at", next))
 }
 
 func deleteEncryptionKeyManager(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api := meta.(*config.Config).GetAPI()
 
+	attempts, err := waitAttempts(data.Get("operation_timeout").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	rootKeyID := data.Get("customer_provided_root_key.0.key_id").(string)
 	if len(rootKeyID) > 0 {
-		return diag.FromErr(removeKey(ctx, api, rootKeyID))
+		return diag.FromErr(removeKey(ctx, api, rootKeyID, attempts))
 	}
 
 	return nil
 }
 
-func removeKey(ctx context.Context, api *management.Management, keyID string) error {
+func removeKey(ctx context.Context, api *management.Management, keyID string, attempts int) error {
 	if err := api.EncryptionKey.Delete(ctx, keyID); err != nil {
 		return err
 	}
 
 	// Wait until the key is actually destroyed.
-	return wait.Until(100, 20, func() (bool, error) {
+	return wait.Until(attempts, waitIntervalSeconds, func() (bool, error) {
 		key, err := api.EncryptionKey.Read(ctx, keyID)
 		if err != nil {
 			return false, err
@@ -273,7 +587,7 @@ func removeKey(ctx context.Context, api *management.Management, keyID string) er
 	})
 }
 
-func importWrappedKey(ctx context.Context, api *management.Management, keyID, wrappedKey *string) error {
+func importWrappedKey(ctx context.Context, api *management.Management, keyID, wrappedKey *string, attempts int) error {
 	encryptionKey := management.EncryptionKey{
 		KID:        keyID,
 		WrappedKey: wrappedKey,
@@ -282,7 +596,7 @@ func importWrappedKey(ctx context.Context, api *management.Management, keyID, wr
 		return err
 	}
 	// Wait until the key is actually activated.
-	return wait.Until(100, 20, func() (bool, error) {
+	return wait.Until(attempts, waitIntervalSeconds, func() (bool, error) {
 		key, err := api.EncryptionKey.Read(ctx, *keyID)
 		if err != nil {
 			return false, err
@@ -291,7 +605,7 @@ func importWrappedKey(ctx context.Context, api *management.Management, keyID, wr
 	})
 }
 
-func createRootKey(ctx context.Context, api *management.Management) (*management.EncryptionKey, *management.WrappingKey, error) {
+func createRootKey(ctx context.Context, api *management.Management, attempts int) (*management.EncryptionKey, *management.WrappingKey, error) {
 	key := management.EncryptionKey{
 		Type: auth0.String("customer-provided-root-key"),
 	}
@@ -300,7 +614,7 @@ func createRootKey(ctx context.Context, api *management.Management) (*management
 	}
 
 	// Wait until the key is actually available.
-	err := wait.Until(100, 20, func() (bool, error) {
+	err := wait.Until(attempts, waitIntervalSeconds, func() (bool, error) {
 		if _, err := api.EncryptionKey.Read(ctx, key.GetKID()); err != nil {
 			if internalError.IsStatusNotFound(err) {
 				return false, nil
@@ -319,4 +633,4 @@ func createRootKey(ctx context.Context, api *management.Management) (*management
 	}
 
 	return &key, wrappingKey, nil
-}
\ No newline at end of file
+}
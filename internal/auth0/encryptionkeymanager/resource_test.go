@@ -0,0 +1,54 @@
+package encryptionkeymanager_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/terraform-provider-auth0/internal/acctest"
+)
+
+// testAccEncryptionKeyManagerLocalWrappingSource exercises wrapping_source's
+// local_file backend through the real auth0_encryption_key_manager
+// resource, rather than unit-testing internal/wrapping/local in isolation,
+// so a regression in how wrapping_source.go wires the backend into
+// computeWrappedKey would also be caught.
+const testAccEncryptionKeyManagerLocalWrappingSource = `
+resource "auth0_encryption_key_manager" "my_keys" {
+	customer_provided_root_key {}
+
+	wrapping_source {
+		local_file {
+			path = "%s"
+		}
+	}
+}
+`
+
+func TestAccEncryptionKeyManagerLocalWrappingSource(t *testing.T) {
+	rootKey := make([]byte, 32)
+	_, err := rand.Read(rootKey)
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(t.TempDir(), "root-key.bin")
+	require.NoError(t, os.WriteFile(keyPath, rootKey, 0o600))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccEncryptionKeyManagerLocalWrappingSource, keyPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("auth0_encryption_key_manager.my_keys", "id"),
+					resource.TestCheckResourceAttr("auth0_encryption_key_manager.my_keys", "wrapped_key_source", "computed"),
+					resource.TestCheckResourceAttrSet("auth0_encryption_key_manager.my_keys", "customer_provided_root_key.0.wrapped_key"),
+				),
+			},
+		},
+	})
+}
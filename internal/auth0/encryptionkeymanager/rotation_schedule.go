@@ -0,0 +1,182 @@
+package encryptionkeymanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/auth0/terraform-provider-auth0/internal/version"
+)
+
+// waitIntervalSeconds is the polling interval used by every wait.Until loop
+// in this package; only the number of attempts is made configurable via
+// operation_timeout.
+const waitIntervalSeconds = 20
+
+// defaultWaitAttempts preserves the provider's original 100*20s = 33m20s
+// budget when operation_timeout is not set.
+const defaultWaitAttempts = 100
+
+// waitAttempts derives the number of wait.Until attempts from the
+// operation_timeout attribute, falling back to defaultWaitAttempts when it
+// is unset.
+func waitAttempts(operationTimeout string) (int, error) {
+	if operationTimeout == "" {
+		return defaultWaitAttempts, nil
+	}
+
+	duration, err := time.ParseDuration(operationTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid operation_timeout %q: %w", operationTimeout, err)
+	}
+
+	attempts := int(duration.Seconds()) / waitIntervalSeconds
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	return attempts, nil
+}
+
+// customizeDiffRotationSchedule computes key_rotation_id from the configured
+// rotation_schedule so a due rotation shows up as plan drift and is carried
+// out by the existing key_rotation_id change detection in
+// updateEncryptionKeyManager.
+func customizeDiffRotationSchedule(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("rotation_schedule.#").(int) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+
+	if notBefore := diff.Get("rotation_schedule.0.not_before").(string); notBefore != "" {
+		t, err := time.Parse(time.RFC3339, notBefore)
+		if err != nil {
+			return fmt.Errorf("invalid rotation_schedule.not_before: %w", err)
+		}
+		if now.Before(t) {
+			return nil
+		}
+	}
+
+	if notAfter := diff.Get("rotation_schedule.0.not_after").(string); notAfter != "" {
+		t, err := time.Parse(time.RFC3339, notAfter)
+		if err != nil {
+			return fmt.Errorf("invalid rotation_schedule.not_after: %w", err)
+		}
+		if now.After(t) {
+			return nil
+		}
+	}
+
+	desiredRotationID, err := desiredKeyRotationID(diff, now)
+	if err != nil {
+		return err
+	}
+
+	if desiredRotationID != "" && desiredRotationID != diff.Get("key_rotation_id").(string) {
+		return diff.SetNew("key_rotation_id", desiredRotationID)
+	}
+
+	return nil
+}
+
+// desiredKeyRotationID evaluates, in order, the interval, rotate_on_apply_after,
+// and rotate_on_provider_upgrade triggers and returns the key_rotation_id
+// that should be in effect, or "" if none of them are due.
+func desiredKeyRotationID(diff *schema.ResourceDiff, now time.Time) (string, error) {
+	var desired string
+
+	if interval := diff.Get("rotation_schedule.0.interval").(string); interval != "" {
+		d, err := time.ParseDuration(interval)
+		if err != nil {
+			return "", fmt.Errorf("invalid rotation_schedule.interval: %w", err)
+		}
+		if d > 0 {
+			desired = fmt.Sprintf("interval-%d", now.Unix()/int64(d.Seconds()))
+		}
+	}
+
+	if rotateAfter := diff.Get("rotation_schedule.0.rotate_on_apply_after").(string); rotateAfter != "" {
+		t, err := time.Parse(time.RFC3339, rotateAfter)
+		if err != nil {
+			return "", fmt.Errorf("invalid rotation_schedule.rotate_on_apply_after: %w", err)
+		}
+		if !now.Before(t) && t.After(lastRotatedAt(diff)) {
+			desired = fmt.Sprintf("apply-after-%d", t.Unix())
+		}
+	}
+
+	if diff.Get("rotation_schedule.0.rotate_on_provider_upgrade").(bool) {
+		if diff.Get("provider_version_at_last_rotation").(string) != version.ProviderVersion {
+			desired = fmt.Sprintf("provider-upgrade-%s", version.ProviderVersion)
+		}
+	}
+
+	return desired, nil
+}
+
+func lastRotatedAt(diff *schema.ResourceDiff) time.Time {
+	raw, ok := diff.Get("last_rotated_at").(string)
+	if !ok || raw == "" {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+// recordRotation stamps last_rotated_at and, when rotate_on_provider_upgrade
+// is enabled, provider_version_at_last_rotation. It runs right after a
+// successful Rekey, and also once on Create so a freshly provisioned
+// resource has a baseline: otherwise an already-due rotate_on_apply_after or
+// a rotate_on_provider_upgrade mismatch computed during the create plan
+// would never be recorded as handled, since Create never calls Rekey.
+func recordRotation(data *schema.ResourceData) error {
+	if err := data.Set("last_rotated_at", time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return err
+	}
+
+	if data.Get("rotation_schedule.0.rotate_on_provider_upgrade").(bool) {
+		if err := data.Set("provider_version_at_last_rotation", version.ProviderVersion); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// computeNextRotationAt returns the next interval boundary, the moment
+// floor(now / rotation_schedule.interval) next changes and
+// customizeDiffRotationSchedule plans a rotation, or "" if no interval is
+// configured.
+func computeNextRotationAt(data *schema.ResourceData) (string, error) {
+	if data.Get("rotation_schedule.#").(int) == 0 {
+		return "", nil
+	}
+
+	interval := data.Get("rotation_schedule.0.interval").(string)
+	if interval == "" {
+		return "", nil
+	}
+
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		return "", fmt.Errorf("invalid rotation_schedule.interval: %w", err)
+	}
+
+	secs := int64(d.Seconds())
+	if secs <= 0 {
+		return "", nil
+	}
+
+	next := (time.Now().UTC().Unix()/secs + 1) * secs
+
+	return time.Unix(next, 0).UTC().Format(time.RFC3339), nil
+}
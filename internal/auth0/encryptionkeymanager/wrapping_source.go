@@ -0,0 +1,107 @@
+package encryptionkeymanager
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping"
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping/aws"
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping/azure"
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping/gcp"
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping/local"
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping/pkcs11"
+)
+
+// expandWrapper builds the wrapping.Wrapper backend selected under the
+// wrapping_source block, or nil if the user has not configured one.
+func expandWrapper(data *schema.ResourceData) (wrapping.Wrapper, error) {
+	if data.Get("wrapping_source.#").(int) == 0 {
+		return nil, nil
+	}
+
+	if cfg, ok := firstElem(data.Get("wrapping_source.0.aws_kms")); ok {
+		return aws.New(cfg["key_arn"].(string), cfg["profile"].(string), cfg["role"].(string)), nil
+	}
+
+	if cfg, ok := firstElem(data.Get("wrapping_source.0.gcp_kms")); ok {
+		return gcp.New(cfg["project"].(string), cfg["location"].(string), cfg["keyring"].(string), cfg["key"].(string)), nil
+	}
+
+	if cfg, ok := firstElem(data.Get("wrapping_source.0.azure_key_vault")); ok {
+		return azure.New(cfg["vault_url"].(string), cfg["key_name"].(string), cfg["key_version"].(string)), nil
+	}
+
+	if cfg, ok := firstElem(data.Get("wrapping_source.0.pkcs11")); ok {
+		return pkcs11.New(cfg["module_path"].(string), cfg["slot_label"].(string), cfg["key_label"].(string), cfg["pin_env"].(string)), nil
+	}
+
+	if cfg, ok := firstElem(data.Get("wrapping_source.0.local_file")); ok {
+		return local.New(cfg["path"].(string)), nil
+	}
+
+	return nil, fmt.Errorf("wrapping_source is set but none of its nested backend blocks are configured")
+}
+
+// firstElem returns the single element of a TypeList attribute's raw value,
+// or ok=false if the list is empty.
+func firstElem(raw interface{}) (map[string]interface{}, bool) {
+	list, ok := raw.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, false
+	}
+
+	elem, ok := list[0].(map[string]interface{})
+	return elem, ok
+}
+
+// computeWrappedKey generates and wraps a fresh root key using the backend
+// configured under wrapping_source, ready for importWrappedKey. It returns
+// nil if no wrapping_source is configured.
+func computeWrappedKey(ctx context.Context, data *schema.ResourceData, publicWrappingKeyPEM string) (*string, error) {
+	wrapper, err := expandWrapper(data)
+	if err != nil {
+		return nil, err
+	}
+	if wrapper == nil {
+		return nil, nil
+	}
+
+	rsaPublicKey, err := parseRSAPublicKeyPEM(publicWrappingKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := wrapper.Wrap(ctx, rsaPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap root key: %w", err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(wrappedKey)
+
+	return &encoded, nil
+}
+
+func parseRSAPublicKeyPEM(publicWrappingKeyPEM string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicWrappingKeyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode public_wrapping_key PEM")
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public_wrapping_key: %w", err)
+	}
+
+	rsaPublicKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public_wrapping_key is not an RSA public key")
+	}
+
+	return rsaPublicKey, nil
+}
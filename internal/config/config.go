@@ -0,0 +1,163 @@
+// Package config configures and exposes the Auth0 Management API client
+// shared by the SDKv2 and plugin-framework providers.
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/auth0/go-auth0/management"
+
+	"github.com/auth0/terraform-provider-auth0/internal/version"
+)
+
+// Config holds the configured Auth0 Management API client.
+type Config struct {
+	api    *management.Management
+	domain string
+}
+
+// GetAPI returns the configured Auth0 Management API client.
+func (c *Config) GetAPI() *management.Management {
+	return c.api
+}
+
+// GetDomain returns the Auth0 tenant domain the provider was configured
+// against, e.g. for building non-Management API tenant requests such as
+// /oauth/token exchanges.
+func (c *Config) GetDomain() string {
+	return c.domain
+}
+
+// ProviderOptions collects everything needed to build the Auth0 Management
+// API client, beyond the version string handed in by Terraform core.
+type ProviderOptions struct {
+	Domain       string
+	ClientID     string
+	ClientSecret string
+	ApiToken     string
+	Audience     string
+	Debug        bool
+
+	// UserAgentExtra is appended to the default
+	// terraform-provider-auth0/<ver> (terraform/<ver>) User-Agent header.
+	UserAgentExtra string
+
+	// HTTPRetryMax is the maximum number of retries on transient errors and
+	// rate limiting. Zero leaves the go-auth0 default in place.
+	HTTPRetryMax int
+
+	// HTTPRetryWaitMin/HTTPRetryWaitMax bound the backoff between retries.
+	// Zero leaves the go-auth0 default in place.
+	HTTPRetryWaitMin time.Duration
+	HTTPRetryWaitMax time.Duration
+
+	// HTTPTimeout bounds how long a single Management API request may take.
+	// Zero leaves the http.Client default in place.
+	HTTPTimeout time.Duration
+
+	// DisableTelemetry suppresses the standard Auth0-Client telemetry header.
+	DisableTelemetry bool
+
+	// ProxyURL overrides the proxy used for Management API requests. Empty
+	// falls back to the environment (HTTPS_PROXY, etc).
+	ProxyURL string
+}
+
+// ConfigureFrameworkProvider builds the Auth0 Management API client used by
+// the plugin-framework provider. Exactly one of ApiToken or
+// ClientID+ClientSecret is expected to be set; ApiToken takes precedence
+// when both are provided.
+func ConfigureFrameworkProvider(terraformVersion string, opts ProviderOptions) (*Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if opts.Domain == "" {
+		diags.AddError(
+			"Missing Auth0 Domain",
+			"The provider cannot be configured without a domain. Set the `domain` attribute or the AUTH0_DOMAIN environment variable.",
+		)
+		return nil, diags
+	}
+
+	httpClient, err := buildHTTPClient(opts)
+	if err != nil {
+		diags.AddError("Invalid HTTP Client Configuration", err.Error())
+		return nil, diags
+	}
+
+	managementOpts := []management.Option{
+		management.WithClient(httpClient),
+		management.WithUserAgent(userAgent(terraformVersion, opts.UserAgentExtra)),
+		management.WithDebug(opts.Debug),
+	}
+	if opts.Audience != "" {
+		managementOpts = append(managementOpts, management.WithStaticAudience(opts.Audience))
+	}
+	if opts.HTTPRetryMax > 0 || opts.HTTPRetryWaitMin > 0 || opts.HTTPRetryWaitMax > 0 {
+		managementOpts = append(managementOpts, management.WithRetries(opts.HTTPRetryMax, opts.HTTPRetryWaitMin, opts.HTTPRetryWaitMax))
+	}
+	if opts.DisableTelemetry {
+		managementOpts = append(managementOpts, management.WithNoDefaults(true))
+	}
+
+	var api *management.Management
+	switch {
+	case opts.ApiToken != "":
+		api, err = management.New(opts.Domain, append([]management.Option{management.WithStaticToken(opts.ApiToken)}, managementOpts...)...)
+	case opts.ClientID != "" && opts.ClientSecret != "":
+		api, err = management.New(opts.Domain, append([]management.Option{
+			management.WithClientCredentials(context.Background(), opts.ClientID, opts.ClientSecret),
+		}, managementOpts...)...)
+	default:
+		diags.AddError(
+			"Missing Auth0 Credentials",
+			"Either `api_token`, or both `client_id` and `client_secret`, must be configured.",
+		)
+		return nil, diags
+	}
+	if err != nil {
+		diags.AddError("Failed to Initialize Auth0 Management API Client", err.Error())
+		return nil, diags
+	}
+
+	return &Config{api: api, domain: opts.Domain}, diags
+}
+
+// userAgent builds the default User-Agent, optionally appending extra.
+//
+// The version reported is this provider's own version (the same value the
+// auth0_provider data source surfaces as provider_version), not
+// management.Version, which is the go-auth0 SDK's own version and says
+// nothing about which provider release is making the request.
+func userAgent(terraformVersion, extra string) string {
+	ua := fmt.Sprintf("terraform-provider-auth0/%s (terraform/%s)", version.ProviderVersion, terraformVersion)
+	if extra != "" {
+		ua = fmt.Sprintf("%s %s", ua, extra)
+	}
+	return ua
+}
+
+// buildHTTPClient applies HTTPTimeout and ProxyURL on top of the standard
+// library's default transport. Retries are handled by go-auth0 itself via
+// management.WithRetries, so they are not duplicated here.
+func buildHTTPClient(opts ProviderOptions) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.ProxyURL != "" {
+		proxyURL, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy_url: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   opts.HTTPTimeout,
+	}, nil
+}
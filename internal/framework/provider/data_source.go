@@ -0,0 +1,58 @@
+// Package provider implements plugin-framework data sources that describe
+// the provider itself, as opposed to any Auth0 tenant resource.
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/auth0/terraform-provider-auth0/internal/version"
+)
+
+// providerDataSource backs the auth0_provider data source, which exposes
+// metadata about the running provider itself so configs and external
+// tooling can introspect it without parsing the User-Agent header.
+type providerDataSource struct{}
+
+// NewProviderDataSource returns the auth0_provider data source.
+func NewProviderDataSource() datasource.DataSource {
+	return &providerDataSource{}
+}
+
+type providerDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ProviderVersion types.String `tfsdk:"provider_version"`
+}
+
+func (d *providerDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_provider"
+}
+
+func (d *providerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Data source to retrieve metadata about the running provider itself.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this resource.",
+			},
+			"provider_version": schema.StringAttribute{
+				Computed: true,
+				Description: "The version of this provider, the same value reported in its " +
+					"User-Agent header. \"dev\" for a locally built provider.",
+			},
+		},
+	}
+}
+
+func (d *providerDataSource) Read(ctx context.Context, _ datasource.ReadRequest, response *datasource.ReadResponse) {
+	data := providerDataSourceModel{
+		ID:              types.StringValue("provider"),
+		ProviderVersion: types.StringValue(version.ProviderVersion),
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
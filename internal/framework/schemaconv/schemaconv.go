@@ -0,0 +1,97 @@
+// Package schemaconv converts existing SDKv2 *schema.Resource field
+// descriptions into framework schema.Attribute skeletons, so porting a
+// resource doesn't start by retyping every Description string by hand. The
+// output is a starting point, not a finished schema: nested TypeList/TypeSet
+// blocks need their Elem converted and reviewed by hand, and validators
+// (ExactlyOneOf, ConflictsWith, ...) are not carried over since the two
+// frameworks express them differently.
+package schemaconv
+
+import (
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ConvertAttributes converts the top-level fields of an SDKv2 schema whose
+// Type is TypeString, TypeBool, TypeInt, or a TypeList/TypeSet of strings
+// into framework attributes; a TypeSet of strings becomes a ListAttribute.
+// All other fields are skipped, including TypeFloat, TypeMap, lists and sets
+// of non-string elements, and TypeList/TypeSet fields with a
+// *schema.Resource Elem; convert those nested blocks by hand with
+// ConvertAttributes on their own Schema map and wrap the result in a
+// schema.ListNestedAttribute/SetNestedAttribute.
+func ConvertAttributes(sdkSchema map[string]*sdkschema.Schema) map[string]fwschema.Attribute {
+	attributes := make(map[string]fwschema.Attribute, len(sdkSchema))
+
+	for name, field := range sdkSchema {
+		attribute, ok := convertAttribute(field)
+		if ok {
+			attributes[name] = attribute
+		}
+	}
+
+	return attributes
+}
+
+func convertAttribute(field *sdkschema.Schema) (fwschema.Attribute, bool) {
+	common := struct {
+		Required  bool
+		Optional  bool
+		Computed  bool
+		Sensitive bool
+	}{
+		Required:  field.Required,
+		Optional:  field.Optional,
+		Computed:  field.Computed,
+		Sensitive: field.Sensitive,
+	}
+
+	switch field.Type {
+	case sdkschema.TypeString:
+		return fwschema.StringAttribute{
+			Required:            common.Required,
+			Optional:            common.Optional,
+			Computed:            common.Computed,
+			Sensitive:           common.Sensitive,
+			Description:         field.Description,
+			MarkdownDescription: field.Description,
+		}, true
+	case sdkschema.TypeBool:
+		return fwschema.BoolAttribute{
+			Required:            common.Required,
+			Optional:            common.Optional,
+			Computed:            common.Computed,
+			Sensitive:           common.Sensitive,
+			Description:         field.Description,
+			MarkdownDescription: field.Description,
+		}, true
+	case sdkschema.TypeInt:
+		return fwschema.Int64Attribute{
+			Required:            common.Required,
+			Optional:            common.Optional,
+			Computed:            common.Computed,
+			Sensitive:           common.Sensitive,
+			Description:         field.Description,
+			MarkdownDescription: field.Description,
+		}, true
+	case sdkschema.TypeList, sdkschema.TypeSet:
+		if elem, ok := field.Elem.(*sdkschema.Schema); ok && elem.Type == sdkschema.TypeString {
+			return fwschema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            common.Required,
+				Optional:            common.Optional,
+				Computed:            common.Computed,
+				Sensitive:           common.Sensitive,
+				Description:         field.Description,
+				MarkdownDescription: field.Description,
+			}, true
+		}
+
+		// Nested *schema.Resource blocks need their own Schema map run through
+		// ConvertAttributes and wrapping in a NestedAttribute by hand.
+		return nil, false
+	default:
+		return nil, false
+	}
+}
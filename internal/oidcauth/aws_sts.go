@@ -0,0 +1,97 @@
+package oidcauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+const stsGetCallerIdentityBody = "Action=GetCallerIdentity&Version=2011-06-15"
+
+// AWSSTSTokenSource produces a SigV4-signed STS GetCallerIdentity request,
+// the same workload identity proof used by AWS IAM authentication methods
+// (e.g. Vault's aws auth backend), for exchange at Auth0's /oauth/token.
+type AWSSTSTokenSource struct {
+	RoleARN  string
+	Audience string
+}
+
+// Token implements TokenSource. The returned string is a base64-encoded
+// JSON envelope containing the signed request, not a JWT.
+func (s AWSSTSTokenSource) Token(ctx context.Context) (string, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	if s.RoleARN != "" {
+		cfg.Credentials = aws.NewCredentialsCache(
+			stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), s.RoleARN),
+		)
+	}
+
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, fmt.Sprintf("https://sts.%s.amazonaws.com/", region), strings.NewReader(stsGetCallerIdentityBody),
+	)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	if s.Audience != "" {
+		req.Header.Set("X-Auth0-Audience", s.Audience)
+	}
+
+	bodyHash := sha256.Sum256([]byte(stsGetCallerIdentityBody))
+	if err := v4.NewSigner().SignHTTP(ctx, creds, req, hex.EncodeToString(bodyHash[:]), "sts", region, time.Now()); err != nil {
+		return "", fmt.Errorf("failed to sign STS GetCallerIdentity request: %w", err)
+	}
+
+	return encodeSignedRequest(req)
+}
+
+// signedRequestEnvelope carries just enough of the signed HTTP request for
+// the federation broker on the other end to replay it against STS.
+type signedRequestEnvelope struct {
+	URL     string              `json:"url"`
+	Method  string              `json:"method"`
+	Headers map[string][]string `json:"headers"`
+	Body    string              `json:"body"`
+}
+
+func encodeSignedRequest(req *http.Request) (string, error) {
+	envelope := signedRequestEnvelope{
+		URL:     req.URL.String(),
+		Method:  req.Method,
+		Headers: map[string][]string(req.Header),
+		Body:    stsGetCallerIdentityBody,
+	}
+
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode signed STS request: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
@@ -0,0 +1,51 @@
+package oidcauth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// refreshSkew is how far ahead of the access token's actual expiry a
+// refresh is triggered, so in-flight Terraform operations never race an
+// expiring token.
+const refreshSkew = 1 * time.Minute
+
+// RefreshFunc fetches a fresh access token and the time at which it expires.
+type RefreshFunc func(ctx context.Context) (token string, expiresAt time.Time, err error)
+
+// Cache caches a Management API access token obtained via OIDC federation
+// and transparently refreshes it shortly before it expires.
+type Cache struct {
+	refresh RefreshFunc
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// NewCache returns a Cache that uses refresh to obtain new tokens.
+func NewCache(refresh RefreshFunc) *Cache {
+	return &Cache{refresh: refresh}
+}
+
+// Token returns a cached access token, refreshing it first if it is missing
+// or close to expiry.
+func (c *Cache) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && time.Until(c.expiresAt) > refreshSkew {
+		return c.token, nil
+	}
+
+	token, expiresAt, err := c.refresh(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	c.token = token
+	c.expiresAt = expiresAt
+
+	return c.token, nil
+}
@@ -0,0 +1,217 @@
+// Package oidcauth implements OIDC workload-identity authentication for the
+// Auth0 provider: fetching a third-party identity token from CI/cloud
+// runtimes and exchanging it for a Management API access token using
+// private_key_jwt / JWT-bearer (RFC 7523), so long-lived client secrets are
+// not required in GitHub Actions, GitLab CI, AWS, or Kubernetes.
+package oidcauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TokenSource fetches a third-party OIDC identity token to be exchanged for
+// an Auth0 Management API access token.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// GitHubActionsTokenSource fetches an ID token from the GitHub Actions OIDC
+// provider using the runner-provided ACTIONS_ID_TOKEN_REQUEST_TOKEN and
+// ACTIONS_ID_TOKEN_REQUEST_URL environment variables.
+type GitHubActionsTokenSource struct {
+	Audience string
+}
+
+// Token implements TokenSource.
+func (s GitHubActionsTokenSource) Token(ctx context.Context) (string, error) {
+	requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	requestURL := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL")
+	if requestToken == "" || requestURL == "" {
+		return "", fmt.Errorf(
+			"ACTIONS_ID_TOKEN_REQUEST_TOKEN and ACTIONS_ID_TOKEN_REQUEST_URL are not set; " +
+				"does this job have the `id-token: write` permission?",
+		)
+	}
+
+	endpoint := requestURL
+	if s.Audience != "" {
+		endpoint = fmt.Sprintf("%s&audience=%s", requestURL, url.QueryEscape(s.Audience))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+requestToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request GitHub Actions ID token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("GitHub Actions OIDC endpoint returned %d: %s", resp.StatusCode, body)
+	}
+
+	var payload struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return "", fmt.Errorf("failed to decode GitHub Actions ID token response: %w", err)
+	}
+
+	return payload.Value, nil
+}
+
+// FileTokenSource reads an ID token projected to a file on disk, as used by
+// most CI systems' OIDC token projection (e.g. GitLab CI's ID_TOKEN_FILE).
+type FileTokenSource struct {
+	Path string
+}
+
+// Token implements TokenSource.
+func (s FileTokenSource) Token(_ context.Context) (string, error) {
+	token, err := os.ReadFile(s.Path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read OIDC token from %q: %w", s.Path, err)
+	}
+
+	return strings.TrimSpace(string(token)), nil
+}
+
+// EnvTokenSource reads an ID token directly from an environment variable.
+type EnvTokenSource struct {
+	Var string
+}
+
+// Token implements TokenSource.
+func (s EnvTokenSource) Token(_ context.Context) (string, error) {
+	token := os.Getenv(s.Var)
+	if token == "" {
+		return "", fmt.Errorf("environment variable %q is not set", s.Var)
+	}
+
+	return token, nil
+}
+
+// BuildClientAssertion signs a JWT-bearer client assertion for exchange at
+// /oauth/token, per RFC 7523. iss and sub are set to clientID and aud to the
+// tenant's base URL (https://<domain>/), as required by Auth0's
+// private_key_jwt implementation, and the assertion is valid for 5 minutes. The workload's third-party identity token is carried
+// in the private idp_assertion claim, so an Auth0 Action bound to the M2M
+// application can verify the caller's workload identity server-side before
+// issuing the access token.
+func BuildClientAssertion(signingKeyPEM, alg, clientID, domain, idpAssertion string) (string, error) {
+	method, key, err := parseSigningKey(signingKeyPEM, alg)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":           clientID,
+		"sub":           clientID,
+		"aud":           fmt.Sprintf("https://%s/", domain),
+		"iat":           now.Unix(),
+		"exp":           now.Add(5 * time.Minute).Unix(),
+		"jti":           newJTI(),
+		"idp_assertion": idpAssertion,
+	}
+
+	return jwt.NewWithClaims(method, claims).SignedString(key)
+}
+
+func parseSigningKey(signingKeyPEM, alg string) (jwt.SigningMethod, interface{}, error) {
+	block, _ := pem.Decode([]byte(signingKeyPEM))
+	if block == nil {
+		return nil, nil, fmt.Errorf("failed to decode client_assertion_signing_key PEM")
+	}
+
+	switch alg {
+	case "RS256":
+		if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return jwt.SigningMethodRS256, key, nil
+		}
+		keyAny, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse RSA client_assertion_signing_key: %w", err)
+		}
+		rsaKey, ok := keyAny.(*rsa.PrivateKey)
+		if !ok {
+			return nil, nil, fmt.Errorf("client_assertion_signing_key is not an RSA private key")
+		}
+		return jwt.SigningMethodRS256, rsaKey, nil
+	case "ES256":
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse EC client_assertion_signing_key: %w", err)
+		}
+		return jwt.SigningMethodES256, key, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported client_assertion_signing_alg %q, must be RS256 or ES256", alg)
+	}
+}
+
+func newJTI() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// ExchangeClientAssertion exchanges a signed client assertion for a
+// Management API access token using grant_type=client_credentials.
+func ExchangeClientAssertion(ctx context.Context, domain, clientAssertion string) (accessToken string, expiresIn int, err error) {
+	form := url.Values{
+		"grant_type":            {"client_credentials"},
+		"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+		"client_assertion":      {clientAssertion},
+		"audience":              {fmt.Sprintf("https://%s/api/v2/", domain)},
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, fmt.Sprintf("https://%s/oauth/token", domain), strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to exchange client assertion: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var payload struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int    `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return "", 0, fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("token exchange failed: %s: %s", payload.Error, payload.ErrorDescription)
+	}
+
+	return payload.AccessToken, payload.ExpiresIn, nil
+}
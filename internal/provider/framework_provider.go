@@ -2,8 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -11,21 +15,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/encryptionkeymanager"
 	"github.com/auth0/terraform-provider-auth0/internal/config"
+	internalError "github.com/auth0/terraform-provider-auth0/internal/error"
+	frameworkprovider "github.com/auth0/terraform-provider-auth0/internal/framework/provider"
+	"github.com/auth0/terraform-provider-auth0/internal/oidcauth"
 )
 
 type auth0Provider struct {
+	oidcTokenCache *oidcauth.Cache
 }
 
 type auth0ProviderModel struct {
-	Domain types.String `tfsdk:"domain"`
+	Domain                    types.String          `tfsdk:"domain"`
+	Audience                  types.String          `tfsdk:"audience"`
+	ClientID                  types.String          `tfsdk:"client_id"`
+	ClientSecret              types.String          `tfsdk:"client_secret"`
+	ApiToken                  types.String          `tfsdk:"api_token"`
+	Debug                     types.Bool            `tfsdk:"debug"`
+	ClientAssertionSigningKey types.String          `tfsdk:"client_assertion_signing_key"`
+	ClientAssertionSigningAlg types.String          `tfsdk:"client_assertion_signing_alg"`
+	OIDCTokenSource           *oidcTokenSourceModel `tfsdk:"oidc_token_source"`
+	UserAgentExtra            types.String          `tfsdk:"user_agent_extra"`
+	HTTPRetryMax              types.Int64           `tfsdk:"http_retry_max"`
+	HTTPRetryWaitMin          types.String          `tfsdk:"http_retry_wait_min"`
+	HTTPRetryWaitMax          types.String          `tfsdk:"http_retry_wait_max"`
+	HTTPTimeout               types.String          `tfsdk:"http_timeout"`
+	DisableTelemetry          types.Bool            `tfsdk:"disable_telemetry"`
+	ProxyURL                  types.String          `tfsdk:"proxy_url"`
+}
+
+type oidcTokenSourceModel struct {
+	GitHubActions *githubActionsTokenSourceModel `tfsdk:"github_actions"`
+	File          *fileTokenSourceModel          `tfsdk:"file"`
+	Env           *envTokenSourceModel           `tfsdk:"env"`
+	AWSSTS        *awsSTSTokenSourceModel        `tfsdk:"aws_sts"`
+}
+
+type githubActionsTokenSourceModel struct{}
+
+type fileTokenSourceModel struct {
+	Path types.String `tfsdk:"path"`
+}
+
+type envTokenSourceModel struct {
+	Var types.String `tfsdk:"var"`
+}
+
+type awsSTSTokenSourceModel struct {
+	RoleARN  types.String `tfsdk:"role_arn"`
 	Audience types.String `tfsdk:"audience"`
-	ClientID types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	ApiToken types.String `tfsdk:"api_token"`
-	Debug types.Bool `tfsdk:"debug"`
 }
 
 func (p *auth0Provider) Metadata(_ context.Context, _ provider.MetadataRequest, _ *provider.MetadataResponse) {
@@ -55,20 +95,24 @@ func (p *auth0Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 						stringvalidator.ConflictsWith(path.Expressions{
 							path.MatchRoot("api_token"),
 						}...),
-						stringvalidator.AlsoRequires(path.Expressions{
-							path.MatchRoot("client_secret"),
-						}...),
+						stringvalidator.Any(
+							stringvalidator.AlsoRequires(path.MatchRoot("client_secret")),
+							stringvalidator.AlsoRequires(path.MatchRoot("client_assertion_signing_key")),
+						),
 					},
-					Description: "Your Auth0 client ID. " +
-						"It can also be sourced from the AUTH0_CLIENT_ID environment variable.",
-					MarkdownDescription: "Your Auth0 client ID. " +
-						"It can also be sourced from the `AUTH0_CLIENT_ID` environment variable.",
+					Description: "Your Auth0 client ID. Used together with either client_secret or " +
+						"client_assertion_signing_key. It can also be sourced from the AUTH0_CLIENT_ID " +
+						"environment variable.",
+					MarkdownDescription: "Your Auth0 client ID. Used together with either `client_secret` " +
+						"or `client_assertion_signing_key`. It can also be sourced from the " +
+						"`AUTH0_CLIENT_ID` environment variable.",
 				},
 				"client_secret": schema.StringAttribute{
 					Optional: true,
 					Validators: []validator.String{
 						stringvalidator.ConflictsWith(path.Expressions{
 							path.MatchRoot("api_token"),
+							path.MatchRoot("client_assertion_signing_key"),
 						}...),
 						stringvalidator.AlsoRequires(path.Expressions{
 							path.MatchRoot("client_id"),
@@ -79,11 +123,97 @@ func (p *auth0Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 					MarkdownDescription: "Your Auth0 client secret. " +
 						"It can also be sourced from the `AUTH0_CLIENT_SECRET` environment variable.",
 				},
+				"client_assertion_signing_key": schema.StringAttribute{
+					Optional:  true,
+					Sensitive: true,
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.Expressions{
+							path.MatchRoot("client_secret"),
+							path.MatchRoot("api_token"),
+						}...),
+						stringvalidator.AlsoRequires(path.Expressions{
+							path.MatchRoot("client_assertion_signing_alg"),
+							path.MatchRoot("oidc_token_source"),
+						}...),
+					},
+					Description: "PEM-encoded private key used to sign the private_key_jwt client assertion " +
+						"exchanged for a Management API token via OIDC workload identity federation. Requires " +
+						"client_assertion_signing_alg and oidc_token_source to also be set.",
+					MarkdownDescription: "PEM-encoded private key used to sign the `private_key_jwt` client " +
+						"assertion exchanged for a Management API token via OIDC workload identity federation. " +
+						"Requires `client_assertion_signing_alg` and `oidc_token_source` to also be set.",
+				},
+				"client_assertion_signing_alg": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("RS256", "ES256"),
+					},
+					Description:         "The signing algorithm used for the client assertion. One of RS256 or ES256.",
+					MarkdownDescription: "The signing algorithm used for the client assertion. One of `RS256` or `ES256`.",
+				},
+				"oidc_token_source": schema.SingleNestedAttribute{
+					Optional: true,
+					Validators: []validator.Object{
+						objectvalidator.ExactlyOneOf(path.Expressions{
+							path.MatchRelative().AtName("github_actions"),
+							path.MatchRelative().AtName("file"),
+							path.MatchRelative().AtName("env"),
+							path.MatchRelative().AtName("aws_sts"),
+						}...),
+					},
+					Description: "Configures how the third-party OIDC identity token is obtained before being " +
+						"exchanged, via the client assertion, for a Management API token. Exactly one nested " +
+						"attribute must be set.",
+					Attributes: map[string]schema.Attribute{
+						"github_actions": schema.SingleNestedAttribute{
+							Optional: true,
+							Description: "Fetches the identity token from the GitHub Actions OIDC provider using " +
+								"the runner-provided ACTIONS_ID_TOKEN_REQUEST_TOKEN/_URL environment variables.",
+							Attributes: map[string]schema.Attribute{},
+						},
+						"file": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Reads the identity token from a file on disk.",
+							Attributes: map[string]schema.Attribute{
+								"path": schema.StringAttribute{
+									Required:    true,
+									Description: "The path to the file containing the identity token.",
+								},
+							},
+						},
+						"env": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Reads the identity token from an environment variable.",
+							Attributes: map[string]schema.Attribute{
+								"var": schema.StringAttribute{
+									Required:    true,
+									Description: "The name of the environment variable holding the identity token.",
+								},
+							},
+						},
+						"aws_sts": schema.SingleNestedAttribute{
+							Optional: true,
+							Description: "Produces a signed AWS STS GetCallerIdentity request as the identity " +
+								"token, the same workload identity proof used by AWS IAM authentication methods.",
+							Attributes: map[string]schema.Attribute{
+								"role_arn": schema.StringAttribute{
+									Optional:    true,
+									Description: "An IAM role ARN to assume before signing the STS request.",
+								},
+								"audience": schema.StringAttribute{
+									Optional:    true,
+									Description: "An audience value to bind into the signed request.",
+								},
+							},
+						},
+					},
+				},
 				"api_token": schema.StringAttribute{
 					Optional: true,
 					Validators: []validator.String{
 						stringvalidator.ConflictsWith(path.Expressions{
 							path.MatchRoot("client_id"),
+							path.MatchRoot("client_assertion_signing_key"),
 						}...),
 						stringvalidator.ConflictsWith(path.Expressions{
 							path.MatchRoot("client_secret"),
@@ -100,10 +230,61 @@ func (p *auth0Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 						"If both are specified, `api_token` will be used over `client_id` + `client_secret` fields.",
 				},
 				"debug": schema.BoolAttribute{
-					Optional: true,
+					Optional:            true,
 					Description:         "Indicates whether to turn on debug mode.",
 					MarkdownDescription: "Indicates whether to turn on debug mode.",
 				},
+				"user_agent_extra": schema.StringAttribute{
+					Optional: true,
+					Description: "A string appended to the default " +
+						"terraform-provider-auth0/<ver> (terraform/<ver>) User-Agent header. " +
+						"It can also be sourced from the AUTH0_USER_AGENT_EXTRA environment variable.",
+					MarkdownDescription: "A string appended to the default " +
+						"`terraform-provider-auth0/<ver> (terraform/<ver>)` User-Agent header. " +
+						"It can also be sourced from the `AUTH0_USER_AGENT_EXTRA` environment variable.",
+				},
+				"http_retry_max": schema.Int64Attribute{
+					Optional: true,
+					Description: "The maximum number of retries on transient errors and rate limiting. " +
+						"It can also be sourced from the AUTH0_HTTP_RETRY_MAX environment variable.",
+					MarkdownDescription: "The maximum number of retries on transient errors and rate limiting. " +
+						"It can also be sourced from the `AUTH0_HTTP_RETRY_MAX` environment variable.",
+				},
+				"http_retry_wait_min": schema.StringAttribute{
+					Optional: true,
+					Description: "The minimum time to wait before retrying, as a Go duration string (e.g. \"1s\"). " +
+						"It can also be sourced from the AUTH0_HTTP_RETRY_WAIT_MIN environment variable.",
+					MarkdownDescription: "The minimum time to wait before retrying, as a Go duration string " +
+						"(e.g. `1s`). It can also be sourced from the `AUTH0_HTTP_RETRY_WAIT_MIN` environment variable.",
+				},
+				"http_retry_wait_max": schema.StringAttribute{
+					Optional: true,
+					Description: "The maximum time to wait before retrying, as a Go duration string (e.g. \"30s\"). " +
+						"It can also be sourced from the AUTH0_HTTP_RETRY_WAIT_MAX environment variable.",
+					MarkdownDescription: "The maximum time to wait before retrying, as a Go duration string " +
+						"(e.g. `30s`). It can also be sourced from the `AUTH0_HTTP_RETRY_WAIT_MAX` environment variable.",
+				},
+				"http_timeout": schema.StringAttribute{
+					Optional: true,
+					Description: "The timeout for a single Management API request, as a Go duration string " +
+						"(e.g. \"30s\"). It can also be sourced from the AUTH0_HTTP_TIMEOUT environment variable.",
+					MarkdownDescription: "The timeout for a single Management API request, as a Go duration " +
+						"string (e.g. `30s`). It can also be sourced from the `AUTH0_HTTP_TIMEOUT` environment variable.",
+				},
+				"disable_telemetry": schema.BoolAttribute{
+					Optional: true,
+					Description: "Suppresses the standard Auth0-Client telemetry header sent with every " +
+						"Management API request. It can also be sourced from the AUTH0_DISABLE_TELEMETRY environment variable.",
+					MarkdownDescription: "Suppresses the standard `Auth0-Client` telemetry header sent with " +
+						"every Management API request. It can also be sourced from the `AUTH0_DISABLE_TELEMETRY` environment variable.",
+				},
+				"proxy_url": schema.StringAttribute{
+					Optional: true,
+					Description: "A proxy URL to use for Management API requests, e.g. for corporate proxies. " +
+						"It can also be sourced from the AUTH0_PROXY_URL environment variable.",
+					MarkdownDescription: "A proxy URL to use for Management API requests, e.g. for corporate " +
+						"proxies. It can also be sourced from the `AUTH0_PROXY_URL` environment variable.",
+				},
 			},
 		}
 	}
@@ -116,14 +297,16 @@ func (p *auth0Provider) Configure(ctx context.Context, request provider.Configur
 	apiToken := os.Getenv("AUTH0_API_TOKEN")
 	audience := os.Getenv("AUTH0_AUDIENCE")
 	debugStr := os.Getenv("AUTH0_DEBUG")
+	userAgentExtra := os.Getenv("AUTH0_USER_AGENT_EXTRA")
+	disableTelemetryStr := os.Getenv("AUTH0_DISABLE_TELEMETRY")
+	proxyURL := os.Getenv("AUTH0_PROXY_URL")
+	httpRetryMaxStr := os.Getenv("AUTH0_HTTP_RETRY_MAX")
+	httpRetryWaitMinStr := os.Getenv("AUTH0_HTTP_RETRY_WAIT_MIN")
+	httpRetryWaitMaxStr := os.Getenv("AUTH0_HTTP_RETRY_WAIT_MAX")
+	httpTimeoutStr := os.Getenv("AUTH0_HTTP_TIMEOUT")
 
-	var debug bool
-	switch debugStr {
-	case "1", "true", "on":
-		debug = true
-	default:
-		debug = false
-	}
+	debug := parseEnvBool(debugStr)
+	disableTelemetry := parseEnvBool(disableTelemetryStr)
 
 	var data auth0ProviderModel
 	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
@@ -146,8 +329,68 @@ func (p *auth0Provider) Configure(ctx context.Context, request provider.Configur
 	if !data.Debug.IsNull() && !data.Debug.IsUnknown() {
 		debug = data.Debug.ValueBool()
 	}
+	if data.UserAgentExtra.ValueString() != "" {
+		userAgentExtra = data.UserAgentExtra.ValueString()
+	}
+	if !data.DisableTelemetry.IsNull() && !data.DisableTelemetry.IsUnknown() {
+		disableTelemetry = data.DisableTelemetry.ValueBool()
+	}
+	if data.ProxyURL.ValueString() != "" {
+		proxyURL = data.ProxyURL.ValueString()
+	}
+
+	if !data.HTTPRetryMax.IsNull() && !data.HTTPRetryMax.IsUnknown() {
+		httpRetryMaxStr = fmt.Sprintf("%d", data.HTTPRetryMax.ValueInt64())
+	}
+	if data.HTTPRetryWaitMin.ValueString() != "" {
+		httpRetryWaitMinStr = data.HTTPRetryWaitMin.ValueString()
+	}
+	if data.HTTPRetryWaitMax.ValueString() != "" {
+		httpRetryWaitMaxStr = data.HTTPRetryWaitMax.ValueString()
+	}
+	if data.HTTPTimeout.ValueString() != "" {
+		httpTimeoutStr = data.HTTPTimeout.ValueString()
+	}
+
+	if data.ClientAssertionSigningKey.ValueString() != "" {
+		if p.oidcTokenCache == nil {
+			p.oidcTokenCache = oidcauth.NewCache(p.refreshOIDCToken(domain, clientID, data))
+		}
+
+		token, err := p.oidcTokenCache.Token(ctx)
+		if err != nil {
+			response.Diagnostics.Append(internalError.DiagnosticsFromError(
+				fmt.Errorf("failed to obtain a Management API token via OIDC workload identity federation: %w", err),
+			)...)
+			return
+		}
+
+		apiToken = token
+	}
 
-	config, diag := config.ConfigureFrameworkProvider(request.TerraformVersion, domain, clientID, clientSecret, apiToken, audience, debug)
+	httpRetryMax, httpRetryWaitMin, httpRetryWaitMax, httpTimeout, err := parseHTTPOptions(
+		httpRetryMaxStr, httpRetryWaitMinStr, httpRetryWaitMaxStr, httpTimeoutStr,
+	)
+	if err != nil {
+		response.Diagnostics.Append(internalError.DiagnosticsFromError(err)...)
+		return
+	}
+
+	config, diag := config.ConfigureFrameworkProvider(request.TerraformVersion, config.ProviderOptions{
+		Domain:           domain,
+		ClientID:         clientID,
+		ClientSecret:     clientSecret,
+		ApiToken:         apiToken,
+		Audience:         audience,
+		Debug:            debug,
+		UserAgentExtra:   userAgentExtra,
+		HTTPRetryMax:     httpRetryMax,
+		HTTPRetryWaitMin: httpRetryWaitMin,
+		HTTPRetryWaitMax: httpRetryWaitMax,
+		HTTPTimeout:      httpTimeout,
+		DisableTelemetry: disableTelemetry,
+		ProxyURL:         proxyURL,
+	})
 	if config != nil {
 		response.ResourceData = config
 		response.DataSourceData = config
@@ -156,12 +399,121 @@ func (p *auth0Provider) Configure(ctx context.Context, request provider.Configur
 	response.Diagnostics.Append(diag...)
 }
 
+// parseEnvBool interprets the common boolean-ish environment variable values
+// used throughout this provider (e.g. AUTH0_DEBUG).
+func parseEnvBool(value string) bool {
+	switch value {
+	case "1", "true", "on":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseHTTPOptions parses the HTTP-layer knobs from their string form, as
+// sourced from either the schema or AUTH0_* environment variables.
+func parseHTTPOptions(retryMaxStr, retryWaitMinStr, retryWaitMaxStr, timeoutStr string) (retryMax int, retryWaitMin, retryWaitMax, timeout time.Duration, err error) {
+	if retryMaxStr != "" {
+		if _, err = fmt.Sscanf(retryMaxStr, "%d", &retryMax); err != nil {
+			return 0, 0, 0, 0, fmt.Errorf("invalid http_retry_max %q: %w", retryMaxStr, err)
+		}
+	}
+	if retryWaitMinStr != "" {
+		if retryWaitMin, err = time.ParseDuration(retryWaitMinStr); err != nil {
+			return 0, 0, 0, 0, fmt.Errorf("invalid http_retry_wait_min %q: %w", retryWaitMinStr, err)
+		}
+	}
+	if retryWaitMaxStr != "" {
+		if retryWaitMax, err = time.ParseDuration(retryWaitMaxStr); err != nil {
+			return 0, 0, 0, 0, fmt.Errorf("invalid http_retry_wait_max %q: %w", retryWaitMaxStr, err)
+		}
+	}
+	if timeoutStr != "" {
+		if timeout, err = time.ParseDuration(timeoutStr); err != nil {
+			return 0, 0, 0, 0, fmt.Errorf("invalid http_timeout %q: %w", timeoutStr, err)
+		}
+	}
+
+	return retryMax, retryWaitMin, retryWaitMax, timeout, nil
+}
+
+// refreshOIDCToken returns a RefreshFunc that fetches a fresh third-party
+// identity token from the configured oidc_token_source, signs a
+// client_assertion with it, and exchanges it at /oauth/token for a
+// Management API access token.
+func (p *auth0Provider) refreshOIDCToken(domain, clientID string, data auth0ProviderModel) oidcauth.RefreshFunc {
+	return func(ctx context.Context) (string, time.Time, error) {
+		tokenSource, err := expandOIDCTokenSource(data.OIDCTokenSource)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+
+		identityToken, err := tokenSource.Token(ctx)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("failed to fetch OIDC identity token: %w", err)
+		}
+
+		clientAssertion, err := oidcauth.BuildClientAssertion(
+			data.ClientAssertionSigningKey.ValueString(),
+			data.ClientAssertionSigningAlg.ValueString(),
+			clientID,
+			domain,
+			identityToken,
+		)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("failed to build client assertion: %w", err)
+		}
+
+		accessToken, expiresIn, err := oidcauth.ExchangeClientAssertion(ctx, domain, clientAssertion)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+
+		return accessToken, time.Now().Add(time.Duration(expiresIn) * time.Second), nil
+	}
+}
+
+// expandOIDCTokenSource builds the oidcauth.TokenSource selected under the
+// oidc_token_source attribute.
+func expandOIDCTokenSource(model *oidcTokenSourceModel) (oidcauth.TokenSource, error) {
+	if model == nil {
+		return nil, fmt.Errorf("oidc_token_source must be set when client_assertion_signing_key is configured")
+	}
+
+	switch {
+	case model.GitHubActions != nil:
+		return oidcauth.GitHubActionsTokenSource{}, nil
+	case model.File != nil:
+		return oidcauth.FileTokenSource{Path: model.File.Path.ValueString()}, nil
+	case model.Env != nil:
+		return oidcauth.EnvTokenSource{Var: model.Env.Var.ValueString()}, nil
+	case model.AWSSTS != nil:
+		return oidcauth.AWSSTSTokenSource{
+			RoleARN:  model.AWSSTS.RoleARN.ValueString(),
+			Audience: model.AWSSTS.Audience.ValueString(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("oidc_token_source must set exactly one of github_actions, file, env, or aws_sts")
+	}
+}
+
 func (p *auth0Provider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		frameworkprovider.NewProviderDataSource,
+	}
 }
 
 func (p *auth0Provider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	// tf6muxserver.NewMuxServer builds its static type-name routing table by
+	// calling Resources() on every underlying server before Configure ever
+	// runs, so which server handles a given resource type can't depend on
+	// provider configuration: it has to be decided here, unconditionally.
+	// auth0_encryption_key_manager itself stays on the SDKv2 provider until
+	// that version is removed, so the framework port is registered under
+	// its own distinct type name for this release.
+	return []func() resource.Resource{
+		encryptionkeymanager.NewEncryptionKeyManagerFrameworkResource,
+	}
 }
 
 // NewAuth0Provider returns a terraform Framework provider.Provider.
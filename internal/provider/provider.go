@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/apikey"
+	"github.com/auth0/terraform-provider-auth0/internal/auth0/encryptionkeymanager"
+	"github.com/auth0/terraform-provider-auth0/internal/config"
+)
+
+// New returns the SDKv2 auth0 provider, muxed alongside NewAuth0Provider in
+// main.go. Its schema mirrors the plugin-framework provider's, and its
+// ConfigureContextFunc builds the same *config.Config both providers share.
+func New() *schema.Provider {
+	p := &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_DOMAIN", nil),
+				Description: "Your Auth0 domain name. It can also be sourced from the AUTH0_DOMAIN environment variable.",
+			},
+			"audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_AUDIENCE", nil),
+				Description: "Your Auth0 audience when using a custom domain. It can also be sourced from the AUTH0_AUDIENCE environment variable.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_CLIENT_ID", nil),
+				Description: "Your Auth0 client ID. Used together with client_secret. It can also be sourced from the AUTH0_CLIENT_ID environment variable.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_CLIENT_SECRET", nil),
+				Description: "Your Auth0 client secret. It can also be sourced from the AUTH0_CLIENT_SECRET environment variable.",
+			},
+			"api_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_API_TOKEN", nil),
+				Description: "Your Auth0 management api access token. It can also be sourced from the AUTH0_API_TOKEN environment variable. " +
+					"It can be used instead of client_id + client_secret. If both are specified, api_token will be used over client_id + client_secret fields.",
+			},
+			"debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_DEBUG", false),
+				Description: "Indicates whether to turn on debug mode.",
+			},
+			"user_agent_extra": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_USER_AGENT_EXTRA", nil),
+				Description: "A string appended to the default terraform-provider-auth0/<ver> (terraform/<ver>) User-Agent header. " +
+					"It can also be sourced from the AUTH0_USER_AGENT_EXTRA environment variable.",
+			},
+			"disable_telemetry": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_DISABLE_TELEMETRY", false),
+				Description: "Suppresses the standard Auth0-Client telemetry header sent with every Management API request. " +
+					"It can also be sourced from the AUTH0_DISABLE_TELEMETRY environment variable.",
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_PROXY_URL", nil),
+				Description: "A proxy URL to use for Management API requests, e.g. for corporate proxies. It can also be sourced from the AUTH0_PROXY_URL environment variable.",
+			},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"auth0_encryption_key_manager": encryptionkeymanager.NewEncryptionKeyManagerResource(),
+			"auth0_management_api_key":     apikey.NewManagementAPIKeyResource(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"auth0_encryption_keys": encryptionkeymanager.NewEncryptionKeysDataSource(),
+		},
+	}
+
+	p.ConfigureContextFunc = configure(p)
+
+	return p
+}
+
+// configure closes over p so ConfigureContextFunc can read p.TerraformVersion,
+// which SDKv2 doesn't otherwise hand to a ConfigureContextFunc.
+func configure(p *schema.Provider) schema.ConfigureContextFunc {
+	return func(_ context.Context, data *schema.ResourceData) (interface{}, sdkdiag.Diagnostics) {
+		cfg, diags := config.ConfigureFrameworkProvider(p.TerraformVersion, config.ProviderOptions{
+			Domain:           data.Get("domain").(string),
+			ClientID:         data.Get("client_id").(string),
+			ClientSecret:     data.Get("client_secret").(string),
+			ApiToken:         data.Get("api_token").(string),
+			Audience:         data.Get("audience").(string),
+			Debug:            data.Get("debug").(bool),
+			UserAgentExtra:   data.Get("user_agent_extra").(string),
+			DisableTelemetry: data.Get("disable_telemetry").(bool),
+			ProxyURL:         data.Get("proxy_url").(string),
+		})
+
+		return cfg, diagnosticsFromFramework(diags)
+	}
+}
+
+// diagnosticsFromFramework converts plugin-framework diagnostics, returned
+// by config.ConfigureFrameworkProvider, into their SDKv2 equivalent, since
+// schema.ConfigureContextFunc can't return the framework's diag.Diagnostics
+// directly.
+func diagnosticsFromFramework(diags diag.Diagnostics) sdkdiag.Diagnostics {
+	var result sdkdiag.Diagnostics
+
+	for _, d := range diags {
+		severity := sdkdiag.Error
+		if d.Severity() == diag.SeverityWarning {
+			severity = sdkdiag.Warning
+		}
+
+		result = append(result, sdkdiag.Diagnostic{
+			Severity: severity,
+			Summary:  d.Summary(),
+			Detail:   d.Detail(),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,18 @@
+// Package value provides small helpers for reading cty.Value attributes
+// produced by schema.ResourceData.GetRawConfig()/GetOkExists-style access
+// patterns, where a nil pointer means "not set" rather than a zero value.
+package value
+
+import "github.com/hashicorp/go-cty/cty"
+
+// String returns a pointer to v's string value, or nil if v is null or
+// unknown.
+func String(v cty.Value) *string {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	s := v.AsString()
+
+	return &s
+}
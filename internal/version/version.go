@@ -0,0 +1,8 @@
+// Package version holds the provider's own release version, as a single
+// value shared by the User-Agent header, the encryption key manager's
+// rotate_on_provider_upgrade tracking, and the auth0_provider data source.
+package version
+
+// ProviderVersion is overridden at build time via -ldflags; it defaults to
+// "dev" for local builds.
+var ProviderVersion = "dev"
@@ -0,0 +1,28 @@
+// Package wait provides a simple fixed-interval polling loop for Management
+// API operations that complete asynchronously, such as encryption key
+// creation, activation and destruction.
+package wait
+
+import (
+	"fmt"
+	"time"
+)
+
+// Until calls check repeatedly, sleeping intervalSeconds between calls, until
+// check reports done or returns an error. It gives up after attempts calls,
+// returning an error describing the timeout.
+func Until(attempts, intervalSeconds int, check func() (bool, error)) error {
+	for i := 0; i < attempts; i++ {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		time.Sleep(time.Duration(intervalSeconds) * time.Second)
+	}
+
+	return fmt.Errorf("timed out after %d attempts", attempts)
+}
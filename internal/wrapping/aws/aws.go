@@ -0,0 +1,73 @@
+// Package aws provides a wrapping.Wrapper backend that sources root key
+// material from AWS KMS.
+package aws
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping"
+)
+
+// Wrapper sources root key material from AWS KMS by requesting a 256-bit
+// data key under the configured key ARN.
+type Wrapper struct {
+	keyARN  string
+	profile string
+	role    string
+}
+
+// New returns a Wrapper backed by the given AWS KMS key ARN. profile and
+// role may be empty, in which case the default credential chain and the
+// caller's own identity are used respectively.
+func New(keyARN, profile, role string) *Wrapper {
+	return &Wrapper{keyARN: keyARN, profile: profile, role: role}
+}
+
+// Wrap implements wrapping.Wrapper.
+func (w *Wrapper) Wrap(ctx context.Context, pubKey *rsa.PublicKey) ([]byte, error) {
+	cfg, err := w.loadConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	output, err := kms.NewFromConfig(cfg).GenerateDataKey(ctx, &kms.GenerateDataKeyInput{
+		KeyId:   &w.keyARN,
+		KeySpec: kmstypes.DataKeySpecAes256,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate data key from AWS KMS key %q: %w", w.keyARN, err)
+	}
+
+	return wrapping.WrapRootKey(pubKey, output.Plaintext)
+}
+
+func (w *Wrapper) loadConfig(ctx context.Context) (aws.Config, error) {
+	var opts []func(*awsconfig.LoadOptions) error
+	if w.profile != "" {
+		opts = append(opts, awsconfig.WithSharedConfigProfile(w.profile))
+	}
+
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return cfg, err
+	}
+
+	if w.role != "" {
+		cfg.Credentials = aws.NewCredentialsCache(
+			stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), w.role),
+		)
+	}
+
+	return cfg, nil
+}
+
+var _ wrapping.Wrapper = (*Wrapper)(nil)
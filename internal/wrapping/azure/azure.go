@@ -0,0 +1,56 @@
+// Package azure provides a wrapping.Wrapper backend that sources root key
+// material protected by Azure Key Vault.
+package azure
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azkeys"
+
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping"
+)
+
+// Wrapper sources the root key material from the vault's GetRandomBytes
+// operation, rather than generating it locally and only using the Key
+// Vault key to wrap/unwrap it afterwards, so the material itself carries
+// HSM provenance rather than just a post-hoc access check. GetRandomBytes
+// is only available on Managed HSM, not standard Key Vault.
+type Wrapper struct {
+	vaultURL   string
+	keyName    string
+	keyVersion string
+}
+
+// New returns a Wrapper backed by the given Managed HSM's vault URL.
+// keyName and keyVersion are retained for future use once Managed HSM
+// supports importing externally-wrapped key material directly under a
+// key; GetRandomBytes only needs the vault URL.
+func New(vaultURL, keyName, keyVersion string) *Wrapper {
+	return &Wrapper{vaultURL: vaultURL, keyName: keyName, keyVersion: keyVersion}
+}
+
+// Wrap implements wrapping.Wrapper.
+func (w *Wrapper) Wrap(ctx context.Context, pubKey *rsa.PublicKey) ([]byte, error) {
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain Azure credentials: %w", err)
+	}
+
+	client, err := azkeys.NewClient(w.vaultURL, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Key Vault client for %q: %w", w.vaultURL, err)
+	}
+
+	count := int32(32)
+	resp, err := client.GetRandomBytes(ctx, azkeys.GetRandomBytesParameters{Count: &count}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate root key material from Managed HSM %q: %w", w.vaultURL, err)
+	}
+
+	return wrapping.WrapRootKey(pubKey, resp.Value)
+}
+
+var _ wrapping.Wrapper = (*Wrapper)(nil)
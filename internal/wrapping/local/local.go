@@ -0,0 +1,40 @@
+// Package local provides a wrapping.Wrapper backend that reads the root key
+// material from a local file, for local development and testing.
+package local
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"os"
+
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping"
+)
+
+// Wrapper sources a raw 256-bit AES key from a file on disk. It exists so
+// the wrapping flow can be exercised without access to a cloud KMS or HSM;
+// production tenants should prefer one of the other backends.
+type Wrapper struct {
+	path string
+}
+
+// New returns a Wrapper that reads the root key material from path.
+func New(path string) *Wrapper {
+	return &Wrapper{path: path}
+}
+
+// Wrap implements wrapping.Wrapper.
+func (w *Wrapper) Wrap(_ context.Context, pubKey *rsa.PublicKey) ([]byte, error) {
+	rootKey, err := os.ReadFile(w.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local root key from %q: %w", w.path, err)
+	}
+
+	if len(rootKey) != 32 {
+		return nil, fmt.Errorf("local root key at %q must be exactly 32 bytes, got %d", w.path, len(rootKey))
+	}
+
+	return wrapping.WrapRootKey(pubKey, rootKey)
+}
+
+var _ wrapping.Wrapper = (*Wrapper)(nil)
@@ -0,0 +1,44 @@
+package local_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping/local"
+)
+
+func TestWrapperWrap(t *testing.T) {
+	rootKey := make([]byte, 32)
+	_, err := rand.Read(rootKey)
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(t.TempDir(), "root-key.bin")
+	require.NoError(t, os.WriteFile(keyPath, rootKey, 0o600))
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	wrapped, err := local.New(keyPath).Wrap(context.Background(), &privateKey.PublicKey)
+	require.NoError(t, err)
+
+	// RSA-OAEP(2048-bit key) output is 256 bytes, AES-KWP of a 32-byte key is 40 bytes.
+	assert.Len(t, wrapped, 256+40)
+}
+
+func TestWrapperWrapInvalidKeyLength(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "root-key.bin")
+	require.NoError(t, os.WriteFile(keyPath, []byte("too-short"), 0o600))
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	_, err = local.New(keyPath).Wrap(context.Background(), &privateKey.PublicKey)
+	assert.ErrorContains(t, err, "must be exactly 32 bytes")
+}
@@ -0,0 +1,118 @@
+// Package pkcs11 provides a wrapping.Wrapper backend that sources root key
+// material from an HSM reachable through a PKCS#11 module.
+package pkcs11
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"os"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping"
+)
+
+// Wrapper generates a 256-bit key on an HSM via PKCS#11 and wraps it for
+// submission to Auth0. The token PIN is never read from configuration; it
+// must be supplied through the environment variable named by pinEnv.
+type Wrapper struct {
+	modulePath string
+	slotLabel  string
+	keyLabel   string
+	pinEnv     string
+}
+
+// New returns a Wrapper backed by the given PKCS#11 module and token.
+func New(modulePath, slotLabel, keyLabel, pinEnv string) *Wrapper {
+	return &Wrapper{modulePath: modulePath, slotLabel: slotLabel, keyLabel: keyLabel, pinEnv: pinEnv}
+}
+
+// Wrap implements wrapping.Wrapper.
+func (w *Wrapper) Wrap(_ context.Context, pubKey *rsa.PublicKey) ([]byte, error) {
+	pin := os.Getenv(w.pinEnv)
+	if pin == "" {
+		return nil, fmt.Errorf("PKCS#11 PIN environment variable %q is not set", w.pinEnv)
+	}
+
+	ctx := pkcs11.New(w.modulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load PKCS#11 module %q", w.modulePath)
+	}
+	defer ctx.Destroy()
+
+	if err := ctx.Initialize(); err != nil {
+		return nil, fmt.Errorf("failed to initialize PKCS#11 module: %w", err)
+	}
+	defer func() { _ = ctx.Finalize() }()
+
+	slot, err := findSlotByLabel(ctx, w.slotLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open PKCS#11 session on slot %q: %w", w.slotLabel, err)
+	}
+	defer func() { _ = ctx.CloseSession(session) }()
+
+	if err := ctx.Login(session, pkcs11.CKU_USER, pin); err != nil {
+		return nil, fmt.Errorf("failed to login to PKCS#11 token %q: %w", w.slotLabel, err)
+	}
+	defer func() { _ = ctx.Logout(session) }()
+
+	rootKey, err := generateRootKey(ctx, session, w.keyLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapping.WrapRootKey(pubKey, rootKey)
+}
+
+func findSlotByLabel(ctx *pkcs11.Ctx, label string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list PKCS#11 slots: %w", err)
+	}
+
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			continue
+		}
+		if info.Label == label {
+			return slot, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no PKCS#11 slot found with token label %q", label)
+}
+
+// generateRootKey asks the HSM to generate a 256-bit AES key under keyLabel
+// and extracts it for wrapping. Modules that mark generated keys as
+// non-extractable are not supported by this backend.
+func generateRootKey(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, keyLabel string) ([]byte, error) {
+	mechanism := []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_GEN, nil)}
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, keyLabel),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE_LEN, 32),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, true),
+	}
+
+	handle, err := ctx.GenerateKey(session, mechanism, template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate root key on HSM under label %q: %w", keyLabel, err)
+	}
+
+	attrs, err := ctx.GetAttributeValue(session, handle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract root key from HSM: %w", err)
+	}
+
+	return attrs[0].Value, nil
+}
+
+var _ wrapping.Wrapper = (*Wrapper)(nil)
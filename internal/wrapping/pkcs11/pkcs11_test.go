@@ -0,0 +1,39 @@
+package pkcs11_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/auth0/terraform-provider-auth0/internal/wrapping/pkcs11"
+)
+
+// TestWrapperWrap exercises Wrap against a software PKCS#11 module such as
+// SoftHSM2, so the HSM key-generation path is covered without requiring
+// physical hardware. It is skipped unless PKCS11_TEST_MODULE_PATH,
+// PKCS11_TEST_SLOT_LABEL, and PKCS11_TEST_PIN_ENV point at a configured
+// software token.
+func TestWrapperWrap(t *testing.T) {
+	modulePath := os.Getenv("PKCS11_TEST_MODULE_PATH")
+	slotLabel := os.Getenv("PKCS11_TEST_SLOT_LABEL")
+	pinEnv := os.Getenv("PKCS11_TEST_PIN_ENV")
+	if modulePath == "" || slotLabel == "" || pinEnv == "" {
+		t.Skip("set PKCS11_TEST_MODULE_PATH, PKCS11_TEST_SLOT_LABEL, and PKCS11_TEST_PIN_ENV " +
+			"to a software PKCS#11 module (e.g. SoftHSM2) to run this test")
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	wrapped, err := pkcs11.New(modulePath, slotLabel, "test-root-key", pinEnv).
+		Wrap(context.Background(), &privateKey.PublicKey)
+	require.NoError(t, err)
+
+	// RSA-OAEP(2048-bit key) output is 256 bytes, AES-KWP of a 32-byte key is 40 bytes.
+	assert.Len(t, wrapped, 256+40)
+}
@@ -0,0 +1,98 @@
+// Package wrapping provides pluggable backends for sourcing the customer
+// provided root key used by auth0_encryption_key_manager and wrapping it
+// for submission to the Management API.
+package wrapping
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec // required by CKM_RSA_AES_KEY_WRAP.
+	"encoding/binary"
+	"fmt"
+)
+
+// Wrapper sources 32 bytes of root key material from a backend-specific
+// location (a cloud KMS, an HSM, a local file, ...) and wraps it under
+// pubKey following the CKM_RSA_AES_KEY_WRAP scheme, ready to be
+// base64-encoded into the wrapped_key attribute.
+type Wrapper interface {
+	Wrap(ctx context.Context, pubKey *rsa.PublicKey) ([]byte, error)
+}
+
+// WrapRootKey wraps the given 32-byte rootKey for submission to Auth0.
+// It generates a fresh ephemeral AES key, wraps that key with RSA-OAEP
+// (SHA-1/MGF1, as required by CKM_RSA_AES_KEY_WRAP) under pubKey, then
+// wraps rootKey with the ephemeral key using AES-KWP (RFC 5649). The
+// result is RSA_OAEP(ephemeral) || AES_KWP(rootKey).
+func WrapRootKey(pubKey *rsa.PublicKey, rootKey []byte) ([]byte, error) {
+	if len(rootKey) != 32 {
+		return nil, fmt.Errorf("root key must be 32 bytes, got %d", len(rootKey))
+	}
+
+	ephemeralKey := make([]byte, 32)
+	if _, err := rand.Read(ephemeralKey); err != nil {
+		return nil, fmt.Errorf("failed to generate ephemeral AES key: %w", err)
+	}
+
+	wrappedEphemeralKey, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, pubKey, ephemeralKey, nil) //nolint:gosec // required by CKM_RSA_AES_KEY_WRAP.
+	if err != nil {
+		return nil, fmt.Errorf("failed to RSA-OAEP wrap the ephemeral key: %w", err)
+	}
+
+	wrappedRootKey, err := kwpWrap(ephemeralKey, rootKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to AES-KWP wrap the root key: %w", err)
+	}
+
+	return append(wrappedEphemeralKey, wrappedRootKey...), nil
+}
+
+// kwpWrap implements the AES Key Wrap with Padding algorithm from RFC 5649,
+// using the alternative initial value defined there. plaintext is always a
+// multiple of 8 bytes in practice here (root keys are 32 bytes), so this
+// only implements the multi-block (n >= 2) wrapping path.
+func kwpWrap(kek, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	paddedLen := ((len(plaintext) + 7) / 8) * 8
+	padded := make([]byte, paddedLen)
+	copy(padded, plaintext)
+
+	n := paddedLen / 8
+	r := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		r[i] = append([]byte{}, padded[i*8:i*8+8]...)
+	}
+
+	a := make([]byte, 8)
+	copy(a, []byte{0xA6, 0x59, 0x59, 0xA6})
+	binary.BigEndian.PutUint32(a[4:], uint32(len(plaintext)))
+
+	buf := make([]byte, 16)
+	enc := make([]byte, 16)
+	for j := 0; j <= 5; j++ {
+		for i := 0; i < n; i++ {
+			copy(buf[:8], a)
+			copy(buf[8:], r[i])
+			block.Encrypt(enc, buf)
+
+			t := uint64(n*j + i + 1)
+			msb := binary.BigEndian.Uint64(enc[:8])
+			binary.BigEndian.PutUint64(a, msb^t)
+			r[i] = append([]byte{}, enc[8:]...)
+		}
+	}
+
+	out := make([]byte, 8+paddedLen)
+	copy(out, a)
+	for i := 0; i < n; i++ {
+		copy(out[8+i*8:], r[i])
+	}
+
+	return out, nil
+}